@@ -0,0 +1,266 @@
+package graph
+
+import "container/heap"
+
+// ReverseGraph is the interface that wraps the Predecessors method,
+// the counterpart of Adjacency for incoming edges.
+// It follows the same convention: nil slice means there is no such vertex.
+type ReverseGraph[K comparable] interface {
+	Predecessors(vertex K) []K
+}
+
+// ReverseIndex stores incoming edges of every vertex of a finite graph.
+// It is a snapshot, later changes of the graph are not reflected.
+type ReverseIndex[K comparable] struct {
+	predecessors map[K][]K
+}
+
+func NewReverseIndex[K comparable](g GraphReader[K]) (*ReverseIndex[K], error) {
+	if g.Order() < 0 {
+		return nil, ErrInfiniteGraph
+	}
+
+	vertices := g.Vertices()
+	r := &ReverseIndex[K]{
+		predecessors: make(map[K][]K, len(vertices)),
+	}
+	for _, vertex := range vertices {
+		if _, ok := r.predecessors[vertex]; !ok {
+			r.predecessors[vertex] = []K{}
+		}
+
+		n := g.Adjacency(vertex)
+		if n == nil {
+			return nil, ErrNilVertex
+		}
+		for _, neighbor := range n {
+			r.predecessors[neighbor] = append(r.predecessors[neighbor], vertex)
+		}
+	}
+
+	return r, nil
+}
+
+func (r *ReverseIndex[K]) Predecessors(vertex K) []K {
+	predecessors, ok := r.predecessors[vertex]
+	if !ok {
+		return nil
+	}
+	return predecessors
+}
+
+// Undirected treats every edge of the graph as going both ways,
+// so predecessors of a vertex are its neighbors.
+// Suits graphs whose edges are always added in pairs, such as Grid.
+func Undirected[K comparable](g Graph[K]) ReverseGraph[K] {
+	return symmetric[K]{g}
+}
+
+type symmetric[K comparable] struct {
+	g Graph[K]
+}
+
+func (s symmetric[K]) Predecessors(vertex K) []K {
+	return s.g.Adjacency(vertex)
+}
+
+// BidirectionalBFS finds a path with the least amount of edges by searching
+// forward from one vertex and backward from the other until both meet.
+// Returns the path and the amount of expanded vertices.
+func BidirectionalBFS[K comparable](g Graph[K], r ReverseGraph[K], from, to K, opts ...SearchOptions) ([]K, int, error) {
+	b := newBudget(opts)
+	if g.Adjacency(from) == nil || r.Predecessors(to) == nil {
+		return nil, 0, ErrNilVertex
+	}
+	if from == to {
+		return []K{from}, 0, nil
+	}
+
+	type side struct {
+		parents  map[K]K
+		dist     map[K]uint
+		frontier []K
+		next     func(vertex K) []K
+	}
+	forward := &side{map[K]K{}, map[K]uint{from: 0}, []K{from}, g.Adjacency}
+	backward := &side{map[K]K{}, map[K]uint{to: 0}, []K{to}, r.Predecessors}
+
+	var expanded int
+	for len(forward.frontier) > 0 && len(backward.frontier) > 0 {
+		current, other := forward, backward
+		if len(backward.frontier) < len(forward.frontier) {
+			current, other = backward, forward
+		}
+
+		// the whole level is expanded, so the shortest meeting is found
+		var meet K
+		var best uint
+		var met bool
+		frontier := current.frontier
+		current.frontier = nil
+		if !b.reaches(current.dist[frontier[0]] + 1 + other.dist[other.frontier[0]]) {
+			return nil, expanded, ErrBudgetExceeded
+		}
+		for _, vertex := range frontier {
+			if err := b.visit(); err != nil {
+				return nil, expanded, err
+			}
+			expanded++
+
+			n := current.next(vertex)
+			if n == nil {
+				return nil, expanded, ErrNilVertex
+			}
+			for _, neighbor := range n {
+				if _, ok := current.dist[neighbor]; ok {
+					continue
+				}
+				current.dist[neighbor] = current.dist[vertex] + 1
+				current.parents[neighbor] = vertex
+				current.frontier = append(current.frontier, neighbor)
+
+				if d, ok := other.dist[neighbor]; ok && (!met || current.dist[neighbor]+d < best) {
+					meet, best, met = neighbor, current.dist[neighbor]+d, true
+				}
+			}
+		}
+
+		if met {
+			path := tracePath(forward.parents, from, meet)
+			for meet != to {
+				meet = backward.parents[meet]
+				path = append(path, meet)
+			}
+			return path, expanded, nil
+		}
+	}
+
+	return nil, expanded, ErrNoPath
+}
+
+// BidirectionalDijkstra finds the cheapest path between two vertices by
+// running Dijkstra forward from one vertex and backward from the other.
+// Edge weights must not be negative.
+// Returns the path and the amount of expanded vertices.
+func BidirectionalDijkstra[K comparable, N Number](g WeightedGraph[K, N], r ReverseGraph[K], from, to K, opts ...SearchOptions) (Path[K, N], int, error) {
+	b := newBudget(opts)
+	if g.Adjacency(from) == nil || r.Predecessors(to) == nil {
+		return Path[K, N]{}, 0, ErrNilVertex
+	}
+	if from == to {
+		return Path[K, N]{Vertices: []K{from}}, 0, nil
+	}
+
+	type side struct {
+		dist    map[K]N
+		hops    map[K]uint
+		parents map[K]K
+		settled map[K]struct{}
+		queue   *priorityQueue[K, N]
+	}
+	newSide := func(entry K) *side {
+		return &side{
+			dist:    map[K]N{entry: 0},
+			hops:    map[K]uint{entry: 0},
+			parents: make(map[K]K),
+			settled: make(map[K]struct{}),
+			queue:   &priorityQueue[K, N]{{vertex: entry}},
+		}
+	}
+	forward, backward := newSide(from), newSide(to)
+
+	// weights are always asked in the direction of the edge
+	neighbors := func(s *side, vertex K) ([]K, []N, error) {
+		if s == forward {
+			n := g.Adjacency(vertex)
+			if n == nil {
+				return nil, nil, ErrNilVertex
+			}
+			weights, err := edgeWeights(g, vertex, n)
+			return n, weights, err
+		}
+
+		n := r.Predecessors(vertex)
+		if n == nil {
+			return nil, nil, ErrNilVertex
+		}
+		edges := make([][2]K, len(n))
+		for i, predecessor := range n {
+			edges[i] = [2]K{predecessor, vertex}
+		}
+		weights := g.EdgesValues(edges...)
+		if len(weights) < len(edges) {
+			return nil, nil, ErrNilVertex
+		}
+		for _, weight := range weights {
+			if weight < 0 {
+				return nil, nil, ErrNegativeWeight
+			}
+		}
+		return n, weights, nil
+	}
+
+	var meet K
+	var best N
+	var met, cut bool
+	var expanded int
+	for forward.queue.Len() > 0 && backward.queue.Len() > 0 {
+		if met && (*forward.queue)[0].cost+(*backward.queue)[0].cost >= best {
+			break
+		}
+
+		current, other := forward, backward
+		if (*backward.queue)[0].cost < (*forward.queue)[0].cost {
+			current, other = backward, forward
+		}
+
+		top := heap.Pop(current.queue).(queueItem[K, N])
+		if _, ok := current.settled[top.vertex]; ok {
+			continue
+		}
+		if !b.reaches(current.hops[top.vertex]) {
+			cut = true
+			continue
+		}
+		if err := b.visit(); err != nil {
+			return Path[K, N]{}, expanded, err
+		}
+		current.settled[top.vertex] = struct{}{}
+		expanded++
+
+		n, weights, err := neighbors(current, top.vertex)
+		if err != nil {
+			return Path[K, N]{}, expanded, err
+		}
+
+		for i, neighbor := range n {
+			cost := top.cost + weights[i]
+			if old, ok := current.dist[neighbor]; !ok || cost < old {
+				current.dist[neighbor] = cost
+				current.hops[neighbor] = current.hops[top.vertex] + 1
+				current.parents[neighbor] = top.vertex
+				heap.Push(current.queue, queueItem[K, N]{neighbor, cost})
+			}
+
+			if d, ok := other.dist[neighbor]; ok {
+				if total := current.dist[neighbor] + d; !met || total < best {
+					meet, best, met = neighbor, total, true
+				}
+			}
+		}
+	}
+
+	if !met {
+		if cut {
+			return Path[K, N]{}, expanded, ErrBudgetExceeded
+		}
+		return Path[K, N]{}, expanded, ErrNoPath
+	}
+
+	path := tracePath(forward.parents, from, meet)
+	for meet != to {
+		meet = backward.parents[meet]
+		path = append(path, meet)
+	}
+	return Path[K, N]{path, best}, expanded, nil
+}
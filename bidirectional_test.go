@@ -0,0 +1,169 @@
+package graph_test
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"testing"
+
+	"github.com/axseem/graph"
+)
+
+func TestBidirectionalDijkstra(t *testing.T) {
+	testCases := append(pathTests(), pathTest{
+		desc:     "meeting in the middle",
+		vertices: []string{"a", "b", "c", "d", "e", "f"},
+		edges: []edge[string, int]{
+			{"a", "b", 1}, {"b", "c", 1}, {"c", "d", 1}, {"d", "f", 1},
+			{"a", "e", 3}, {"e", "f", 2},
+		},
+		from: "a",
+		to:   "f",
+		path: []string{"a", "b", "c", "d", "f"},
+		cost: 4,
+	})
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := newWeighted(tC.vertices, tC.edges)
+			r, err := graph.NewReverseIndex(g)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			path, _, err := graph.BidirectionalDijkstra(g, r, tC.from, tC.to)
+			if err != tC.err {
+				t.Fatalf("expected error: %v, got: %v", tC.err, err)
+			}
+			if !reflect.DeepEqual(tC.path, path.Vertices) || tC.cost != path.Cost {
+				t.Errorf("expected: %v %v, got: %v %v", tC.path, tC.cost, path.Vertices, path.Cost)
+			}
+		})
+	}
+}
+
+func TestBidirectionalBFS(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		order uint
+		edges [][2]uint
+		from  uint
+		to    uint
+		path  []uint
+		err   error
+	}{
+		{
+			desc:  "empty graph",
+			order: 0,
+			err:   graph.ErrNilVertex,
+		},
+		{
+			desc:  "trivial graph",
+			order: 1,
+			path:  []uint{0},
+		},
+		{
+			desc:  "graph:0←1",
+			order: 2,
+			edges: [][2]uint{{1, 0}},
+			from:  0,
+			to:    1,
+			err:   graph.ErrNoPath,
+		},
+		{
+			desc:  "3 vertices cycled directed graph",
+			order: 3,
+			edges: [][2]uint{{0, 1}, {1, 2}, {2, 0}},
+			from:  1,
+			to:    0,
+			path:  []uint{1, 2, 0},
+		},
+		{
+			desc:  "shortcut",
+			order: 6,
+			edges: [][2]uint{{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 5}, {0, 4}},
+			from:  0,
+			to:    5,
+			path:  []uint{0, 4, 5},
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := newIndexed(tC.order, tC.edges)
+			r, err := graph.NewReverseIndex[uint](g)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			path, _, err := graph.BidirectionalBFS[uint](g, r, tC.from, tC.to)
+			if err != tC.err {
+				t.Fatalf("expected error: %v, got: %v", tC.err, err)
+			}
+			if !reflect.DeepEqual(tC.path, path) {
+				t.Errorf("expected: %v, got: %v", tC.path, path)
+			}
+		})
+	}
+}
+
+func TestBidirectionalBFSGrid(t *testing.T) {
+	g := graph.NewGrid()
+	g.DeleteVertices([2]int{1, 0}, [2]int{1, 1}, [2]int{1, -1})
+
+	path, expanded, err := graph.BidirectionalBFS(g, graph.Undirected[[2]int](g), [2]int{0, 0}, [2]int{2, 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(path) != 7 || path[0] != [2]int{0, 0} || path[6] != [2]int{2, 0} {
+		t.Errorf("expected path of 7 vertices around the wall, got: %v", path)
+	}
+	for i := 1; i < len(path); i++ {
+		dx, dy := path[i][0]-path[i-1][0], path[i][1]-path[i-1][1]
+		if dx*dx+dy*dy != 1 {
+			t.Errorf("expected neighbors, got: %v and %v", path[i-1], path[i])
+		}
+	}
+
+	single := 0
+	err = graph.BFS(g, [2]int{0, 0}, func(vertex [2]int, depth uint) bool {
+		single++
+		return vertex != [2]int{2, 0}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expanded >= single {
+		t.Errorf("expected fewer expansions than BFS: %v, got: %v", single, expanded)
+	}
+}
+
+func TestBidirectionalDijkstraRandom(t *testing.T) {
+	rng := rand.New(rand.NewPCG(2, 0))
+	for i := range 100 {
+		order := 2 + rng.IntN(20)
+		vertices := make([]int, order)
+		for v := range vertices {
+			vertices[v] = v
+		}
+		edges := []edge[int, int]{}
+		for range rng.IntN(order * 3) {
+			u, v := rng.IntN(order), rng.IntN(order)
+			if u != v {
+				edges = append(edges, edge[int, int]{u, v, rng.IntN(10)})
+			}
+		}
+		g := newWeighted(vertices, edges)
+		r, err := graph.NewReverseIndex(g)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		from, to := rng.IntN(order), rng.IntN(order)
+		expect, expectErr := graph.Dijkstra(g, from, to)
+		path, _, err := graph.BidirectionalDijkstra(g, r, from, to)
+		if err != expectErr || path.Cost != expect.Cost {
+			t.Errorf("graph %d expected: %v %v, got: %v %v", i, expect, expectErr, path, err)
+		}
+	}
+}
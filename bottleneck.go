@@ -0,0 +1,290 @@
+package graph
+
+import (
+	"container/heap"
+	"slices"
+)
+
+// WidestPath finds the path whose narrowest edge is the widest,
+// such as the path with the most bandwidth when weights are capacities.
+// Cost of the path is the weight of its narrowest edge,
+// a path without edges costs zero.
+func WidestPath[K comparable, N Number](g WeightedGraph[K, N], from, to K, opts ...SearchOptions) (Path[K, N], error) {
+	return bottleneckPath(g, from, to, func(a, b N) bool { return a > b }, newBudget(opts))
+}
+
+// MinimaxPath finds the path whose heaviest edge is the lightest.
+// Cost of the path is the weight of its heaviest edge,
+// a path without edges costs zero.
+func MinimaxPath[K comparable, N Number](g WeightedGraph[K, N], from, to K, opts ...SearchOptions) (Path[K, N], error) {
+	return bottleneckPath(g, from, to, func(a, b N) bool { return a < b }, newBudget(opts))
+}
+
+// bottleneckPath runs Dijkstra where the cost of a path is its worst edge
+// instead of the sum of them. Weights are compared with better.
+func bottleneckPath[K comparable, N Number](g WeightedGraph[K, N], from, to K, better func(a, b N) bool, b *budget) (Path[K, N], error) {
+	value := make(map[K]N)
+	hops := map[K]uint{from: 0}
+	parents := make(map[K]K)
+	settled := make(map[K]struct{})
+	queue := &bottleneckQueue[K, N]{better: better, items: []queueItem[K, N]{{vertex: from}}}
+	var cut bool
+
+	for queue.Len() > 0 {
+		top := heap.Pop(queue).(queueItem[K, N])
+		if _, ok := settled[top.vertex]; ok {
+			continue
+		}
+
+		if !b.reaches(hops[top.vertex]) {
+			cut = true
+			continue
+		}
+		if err := b.visit(); err != nil {
+			return Path[K, N]{}, err
+		}
+		settled[top.vertex] = struct{}{}
+
+		if top.vertex == to {
+			return Path[K, N]{tracePath(parents, from, to), top.cost}, nil
+		}
+
+		n := g.Adjacency(top.vertex)
+		if n == nil {
+			return Path[K, N]{}, ErrNilVertex
+		}
+		weights, err := edgeValues(g, top.vertex, n)
+		if err != nil {
+			return Path[K, N]{}, err
+		}
+
+		for i, neighbor := range n {
+			if _, ok := settled[neighbor]; ok {
+				continue
+			}
+
+			// the entry has no edges yet, so the first edge is the bottleneck
+			cost := weights[i]
+			if top.vertex != from && better(cost, top.cost) {
+				cost = top.cost
+			}
+			if old, ok := value[neighbor]; ok && !better(cost, old) {
+				continue
+			}
+			value[neighbor] = cost
+			hops[neighbor] = hops[top.vertex] + 1
+			parents[neighbor] = top.vertex
+			heap.Push(queue, queueItem[K, N]{neighbor, cost})
+		}
+	}
+
+	if cut {
+		return Path[K, N]{}, ErrBudgetExceeded
+	}
+	return Path[K, N]{}, ErrNoPath
+}
+
+// BottleneckTree answers bottleneck path queries between any two vertices
+// of an undirected graph. It holds a spanning forest in which the path
+// between two vertices has the best bottleneck among all paths of the graph.
+// It is a snapshot, later changes of the graph are not reflected.
+type BottleneckTree[K comparable, N Number] struct {
+	parent map[K]K
+	weight map[K]N
+	depth  map[K]int
+	better func(a, b N) bool
+}
+
+// NewWidestTree builds a maximum spanning forest, so its paths are the widest.
+// Every edge is treated as going both ways.
+func NewWidestTree[K comparable, N Number](g WeightedGraphReader[K, N]) (*BottleneckTree[K, N], error) {
+	return newBottleneckTree(g, func(a, b N) bool { return a > b })
+}
+
+// NewMinimaxTree builds a minimum spanning forest, so its paths are minimax paths.
+// Every edge is treated as going both ways.
+func NewMinimaxTree[K comparable, N Number](g WeightedGraphReader[K, N]) (*BottleneckTree[K, N], error) {
+	return newBottleneckTree(g, func(a, b N) bool { return a < b })
+}
+
+// newBottleneckTree runs Kruskal's algorithm taking the best edges first.
+func newBottleneckTree[K comparable, N Number](g WeightedGraphReader[K, N], better func(a, b N) bool) (*BottleneckTree[K, N], error) {
+	c, err := newCompact(g)
+	if err != nil {
+		return nil, err
+	}
+
+	type treeEdge struct {
+		u, v   int
+		weight N
+	}
+	var edges []treeEdge
+	for u, neighbors := range c.adj {
+		weights, err := edgeValues(g, c.vertices[u], g.Adjacency(c.vertices[u]))
+		if err != nil {
+			return nil, err
+		}
+		for i, v := range neighbors {
+			if u != v {
+				edges = append(edges, treeEdge{u, v, weights[i]})
+			}
+		}
+	}
+	slices.SortStableFunc(edges, func(a, b treeEdge) int {
+		switch {
+		case better(a.weight, b.weight):
+			return -1
+		case better(b.weight, a.weight):
+			return 1
+		}
+		return 0
+	})
+
+	n := c.order()
+	sets := newDisjointSet(n)
+	forest := make([][]treeEdge, n)
+	for _, e := range edges {
+		if sets.union(e.u, e.v) {
+			forest[e.u] = append(forest[e.u], e)
+			forest[e.v] = append(forest[e.v], treeEdge{e.v, e.u, e.weight})
+		}
+	}
+
+	t := &BottleneckTree[K, N]{
+		parent: make(map[K]K, n),
+		weight: make(map[K]N, n),
+		depth:  make(map[K]int, n),
+		better: better,
+	}
+	depth := make([]int, n)
+	for i := range depth {
+		depth[i] = -1
+	}
+	for root := range n {
+		if depth[root] != -1 {
+			continue
+		}
+
+		depth[root] = 0
+		queue := []int{root}
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			t.depth[c.vertices[u]] = depth[u]
+
+			for _, e := range forest[u] {
+				if depth[e.v] != -1 {
+					continue
+				}
+				depth[e.v] = depth[u] + 1
+				t.parent[c.vertices[e.v]] = c.vertices[u]
+				t.weight[c.vertices[e.v]] = e.weight
+				queue = append(queue, e.v)
+			}
+		}
+	}
+
+	return t, nil
+}
+
+// Path returns the path between two vertices with the best bottleneck.
+// Cost of the path is its bottleneck, a path without edges costs zero.
+func (t *BottleneckTree[K, N]) Path(from, to K) (Path[K, N], error) {
+	fromDepth, ok := t.depth[from]
+	if !ok {
+		return Path[K, N]{}, ErrNilVertex
+	}
+	toDepth, ok := t.depth[to]
+	if !ok {
+		return Path[K, N]{}, ErrNilVertex
+	}
+
+	// both ends climb towards their lowest common ancestor
+	head, tail := []K{from}, []K{to}
+	var cost N
+	var edges bool
+	climb := func(path []K) []K {
+		vertex := path[len(path)-1]
+		if weight := t.weight[vertex]; !edges || t.better(cost, weight) {
+			cost = weight
+		}
+		edges = true
+		return append(path, t.parent[vertex])
+	}
+
+	for ; fromDepth > toDepth; fromDepth-- {
+		head = climb(head)
+	}
+	for ; toDepth > fromDepth; toDepth-- {
+		tail = climb(tail)
+	}
+	for head[len(head)-1] != tail[len(tail)-1] {
+		if fromDepth == 0 {
+			return Path[K, N]{}, ErrNoPath
+		}
+		head, tail = climb(head), climb(tail)
+		fromDepth--
+	}
+
+	for i := len(tail) - 2; i >= 0; i-- {
+		head = append(head, tail[i])
+	}
+	return Path[K, N]{head, cost}, nil
+}
+
+// bottleneckQueue implements heap.Interface, the best item is on top.
+type bottleneckQueue[K comparable, N Number] struct {
+	items  []queueItem[K, N]
+	better func(a, b N) bool
+}
+
+func (q *bottleneckQueue[K, N]) Len() int {
+	return len(q.items)
+}
+
+func (q *bottleneckQueue[K, N]) Less(i, j int) bool {
+	return q.better(q.items[i].cost, q.items[j].cost)
+}
+
+func (q *bottleneckQueue[K, N]) Swap(i, j int) {
+	q.items[i], q.items[j] = q.items[j], q.items[i]
+}
+
+func (q *bottleneckQueue[K, N]) Push(x any) {
+	q.items = append(q.items, x.(queueItem[K, N]))
+}
+
+func (q *bottleneckQueue[K, N]) Pop() any {
+	item := q.items[len(q.items)-1]
+	q.items = q.items[:len(q.items)-1]
+	return item
+}
+
+// disjointSet is a union-find structure over integers from zero to n.
+type disjointSet []int
+
+func newDisjointSet(n int) disjointSet {
+	s := make(disjointSet, n)
+	for i := range s {
+		s[i] = i
+	}
+	return s
+}
+
+func (s disjointSet) find(x int) int {
+	for s[x] != x {
+		s[x] = s[s[x]]
+		x = s[x]
+	}
+	return x
+}
+
+// union merges sets of both elements, returns false if it is the same set.
+func (s disjointSet) union(x, y int) bool {
+	x, y = s.find(x), s.find(y)
+	if x == y {
+		return false
+	}
+	s[y] = x
+	return true
+}
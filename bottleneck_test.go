@@ -0,0 +1,120 @@
+package graph_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/axseem/graph"
+)
+
+// network is an undirected graph whose weights are link capacities.
+func network() *weighted[string, int] {
+	links := []edge[string, int]{
+		{"a", "b", 4}, {"a", "c", 9}, {"b", "d", 7}, {"c", "d", 3},
+		{"c", "e", 6}, {"e", "d", 5}, {"f", "g", 1},
+	}
+	var edges []edge[string, int]
+	for _, l := range links {
+		edges = append(edges, l, edge[string, int]{l.to, l.from, l.weight})
+	}
+	return newWeighted([]string{"a", "b", "c", "d", "e", "f", "g"}, edges)
+}
+
+func TestBottleneckPath(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		widest   bool
+		from, to string
+		path     []string
+		cost     int
+		err      error
+	}{
+		{
+			desc:   "nil vertex",
+			widest: true,
+			from:   "x",
+			to:     "a",
+			err:    graph.ErrNilVertex,
+		},
+		{
+			desc:   "no path",
+			widest: true,
+			from:   "a",
+			to:     "f",
+			err:    graph.ErrNoPath,
+		},
+		{
+			desc:   "trivial path",
+			widest: true,
+			from:   "a",
+			to:     "a",
+			path:   []string{"a"},
+		},
+		{
+			desc:   "widest",
+			widest: true,
+			from:   "a",
+			to:     "d",
+			path:   []string{"a", "c", "e", "d"},
+			cost:   5,
+		},
+		{
+			desc: "minimax",
+			from: "a",
+			to:   "d",
+			path: []string{"a", "b", "d"},
+			cost: 7,
+		},
+		{
+			desc: "minimax single edge",
+			from: "c",
+			to:   "d",
+			path: []string{"c", "d"},
+			cost: 3,
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := network()
+
+			find, build := graph.MinimaxPath[string, int], graph.NewMinimaxTree[string, int]
+			if tC.widest {
+				find, build = graph.WidestPath[string, int], graph.NewWidestTree[string, int]
+			}
+
+			path, err := find(g, tC.from, tC.to)
+			if err != tC.err {
+				t.Fatalf("expected error: %v, got: %v", tC.err, err)
+			}
+			if !reflect.DeepEqual(tC.path, path.Vertices) || tC.cost != path.Cost {
+				t.Errorf("expected: %v %v, got: %v %v", tC.path, tC.cost, path.Vertices, path.Cost)
+			}
+
+			tree, err := build(g)
+			if err != nil {
+				t.Fatal(err)
+			}
+			path, err = tree.Path(tC.from, tC.to)
+			if err != tC.err {
+				t.Fatalf("expected tree error: %v, got: %v", tC.err, err)
+			}
+			if !reflect.DeepEqual(tC.path, path.Vertices) || tC.cost != path.Cost {
+				t.Errorf("expected tree: %v %v, got: %v %v", tC.path, tC.cost, path.Vertices, path.Cost)
+			}
+		})
+	}
+}
+
+func TestWidestPathDirected(t *testing.T) {
+	g := newWeighted([]int{0, 1, 2, 3}, []edge[int, int]{
+		{0, 1, 10}, {1, 3, 2}, {0, 2, 3}, {2, 3, 3}, {3, 0, 100},
+	})
+
+	path, err := graph.WidestPath(g, 0, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expect := []int{0, 2, 3}; !reflect.DeepEqual(expect, path.Vertices) || path.Cost != 3 {
+		t.Errorf("expected: %v %v, got: %v %v", expect, 3, path.Vertices, path.Cost)
+	}
+}
@@ -0,0 +1,69 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// SearchOptions limits the work of traversal and pathfinding functions.
+// Zero values mean no limit. When a limit is hit, functions return
+// ErrBudgetExceeded along with whatever they have found so far.
+type SearchOptions struct {
+	Context context.Context
+	// Maximum amount of visited vertices.
+	MaxVisited int
+	// Vertices deeper than this are not visited.
+	MaxDepth uint
+	Timeout  time.Duration
+}
+
+// budget tracks the limits of a single search.
+// Only the last of passed options is used.
+type budget struct {
+	ctx        context.Context
+	deadline   time.Time
+	maxVisited int
+	maxDepth   uint
+	visited    int
+}
+
+func newBudget(opts []SearchOptions) *budget {
+	b := &budget{}
+	if len(opts) == 0 {
+		return b
+	}
+
+	o := opts[len(opts)-1]
+	b.ctx = o.Context
+	b.maxVisited = o.MaxVisited
+	b.maxDepth = o.MaxDepth
+	if o.Timeout > 0 {
+		b.deadline = time.Now().Add(o.Timeout)
+	}
+	return b
+}
+
+// visit counts a vertex about to be visited.
+// Fails if the vertex does not fit into the budget.
+func (b *budget) visit() error {
+	if b.maxVisited > 0 && b.visited >= b.maxVisited {
+		return ErrBudgetExceeded
+	}
+	if b.ctx != nil {
+		if err := b.ctx.Err(); err != nil {
+			return fmt.Errorf("%w: %w", ErrBudgetExceeded, err)
+		}
+	}
+	if !b.deadline.IsZero() && time.Now().After(b.deadline) {
+		return fmt.Errorf("%w: %w", ErrBudgetExceeded, context.DeadlineExceeded)
+	}
+
+	b.visited++
+	return nil
+}
+
+// reaches reports whether vertices at the given depth are within the budget.
+func (b *budget) reaches(depth uint) bool {
+	return b.maxDepth == 0 || depth <= b.maxDepth
+}
@@ -0,0 +1,168 @@
+package graph_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/axseem/graph"
+)
+
+func TestSearchOptionsGrid(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	testCases := []struct {
+		desc    string
+		opts    graph.SearchOptions
+		visited int
+		cause   error
+	}{
+		{
+			desc:    "max visited",
+			opts:    graph.SearchOptions{MaxVisited: 10},
+			visited: 10,
+		},
+		{
+			desc:    "max depth",
+			opts:    graph.SearchOptions{MaxDepth: 2},
+			visited: 13,
+		},
+		{
+			desc:    "cancelled context",
+			opts:    graph.SearchOptions{Context: cancelled},
+			visited: 0,
+			cause:   context.Canceled,
+		},
+		{
+			desc:    "timeout",
+			opts:    graph.SearchOptions{Timeout: time.Millisecond, MaxVisited: 1e7},
+			visited: -1,
+			cause:   context.DeadlineExceeded,
+		},
+	}
+
+	searches := map[string]func(g *graph.Grid, opts graph.SearchOptions, visit func()) error{
+		"BFS": func(g *graph.Grid, opts graph.SearchOptions, visit func()) error {
+			return graph.BFS(g, [2]int{0, 0}, func(vertex [2]int, depth uint) bool {
+				visit()
+				return true
+			}, opts)
+		},
+		"MultiBFS": func(g *graph.Grid, opts graph.SearchOptions, visit func()) error {
+			return graph.MultiBFS(g, [][2]int{{0, 0}}, func(vertex, source [2]int, depth uint) bool {
+				visit()
+				return true
+			}, opts)
+		},
+		"BFSTree": func(g *graph.Grid, opts graph.SearchOptions, visit func()) error {
+			_, err := graph.BFSTree(g, [2]int{0, 0}, func(vertex [2]int, depth uint) bool {
+				visit()
+				return true
+			}, opts)
+			return err
+		},
+		"BFSIterator": func(g *graph.Grid, opts graph.SearchOptions, visit func()) error {
+			it := graph.NewBFSIterator(g, [2]int{0, 0}, opts)
+			for range it.All() {
+				visit()
+			}
+			return it.Err()
+		},
+	}
+
+	for name, search := range searches {
+		for _, tC := range testCases {
+			t.Run(name+" "+tC.desc, func(t *testing.T) {
+				visited := 0
+				err := search(graph.NewGrid(), tC.opts, func() { visited++ })
+				if !errors.Is(err, graph.ErrBudgetExceeded) {
+					t.Fatalf("expected: %v, got: %v", graph.ErrBudgetExceeded, err)
+				}
+				if tC.cause != nil && !errors.Is(err, tC.cause) {
+					t.Errorf("expected: %v, got: %v", tC.cause, err)
+				}
+				if tC.visited >= 0 && visited != tC.visited {
+					t.Errorf("expected visited: %v, got: %v", tC.visited, visited)
+				}
+			})
+		}
+	}
+}
+
+func TestSearchOptionsDepthFits(t *testing.T) {
+	g := newIndexed(3, [][2]uint{{0, 1}, {1, 2}})
+	opts := graph.SearchOptions{MaxDepth: 2}
+
+	if err := graph.BFS(g, 0, func(vertex, depth uint) bool { return true }, opts); err != nil {
+		t.Errorf("BFS expected: %v, got: %v", nil, err)
+	}
+	if err := graph.DFS(g, 0, func(vertex uint) bool { return true }, opts); err != nil {
+		t.Errorf("DFS expected: %v, got: %v", nil, err)
+	}
+	if _, err := graph.DFSTree(g, 0, func(vertex uint) bool { return true }, opts); err != nil {
+		t.Errorf("DFSTree expected: %v, got: %v", nil, err)
+	}
+}
+
+func TestSearchOptionsDFSDepth(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		edges [][2]uint
+		path  []uint
+		err   error
+	}{
+		{
+			desc:  "graph:0→1→2→3",
+			edges: [][2]uint{{0, 1}, {1, 2}, {2, 3}},
+			path:  []uint{0, 1, 2},
+			err:   graph.ErrBudgetExceeded,
+		},
+		{
+			desc:  "too deep vertex reached by shorter path",
+			edges: [][2]uint{{0, 3}, {0, 1}, {1, 2}, {2, 3}},
+			path:  []uint{0, 1, 2, 3},
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := newIndexed(4, tC.edges)
+			opts := graph.SearchOptions{MaxDepth: 2}
+
+			path := []uint{}
+			err := graph.DFS(g, 0, func(vertex uint) bool {
+				path = append(path, vertex)
+				return true
+			}, opts)
+			if err != tC.err {
+				t.Errorf("DFS expected: %v, got: %v", tC.err, err)
+			}
+			if !reflect.DeepEqual(tC.path, path) {
+				t.Errorf("DFS expected: %v, got: %v", tC.path, path)
+			}
+
+			tree, err := graph.DFSTree(g, 0, func(vertex uint) bool { return true }, opts)
+			if err != tC.err {
+				t.Errorf("DFSTree expected: %v, got: %v", tC.err, err)
+			}
+			if !reflect.DeepEqual(tC.path, tree.Discovery) {
+				t.Errorf("DFSTree expected: %v, got: %v", tC.path, tree.Discovery)
+			}
+
+			it := graph.NewDFSIterator(g, 0, opts)
+			path = []uint{}
+			for vertex := range it.All() {
+				path = append(path, vertex)
+			}
+			if it.Err() != tC.err {
+				t.Errorf("DFSIterator expected: %v, got: %v", tC.err, it.Err())
+			}
+			if !reflect.DeepEqual(tC.path, path) {
+				t.Errorf("DFSIterator expected: %v, got: %v", tC.path, path)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package graph
+
+import "math"
+
+// EigenvectorCentrality computes the centrality of each vertex by power
+// iteration, where a vertex is important if it is pointed to by other
+// important vertices. Result is normalized to unit euclidean length.
+// Returns ErrNoConvergence if the tolerance is not reached in maxIterations.
+func EigenvectorCentrality[K comparable](g GraphReader[K], tolerance float64, maxIterations int) (map[K]float64, error) {
+	c, err := newCompact(g)
+	if err != nil {
+		return nil, err
+	}
+
+	n := c.order()
+	if n == 0 {
+		return map[K]float64{}, nil
+	}
+
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = 1 / float64(n)
+	}
+
+	for range maxIterations {
+		// iterating with A+I instead of A keeps the power iteration
+		// from oscillating on bipartite graphs, eigenvectors are the same
+		next := make([]float64, n)
+		copy(next, x)
+		for u, neighbors := range c.adj {
+			for _, v := range neighbors {
+				next[v] += x[u]
+			}
+		}
+
+		if !normalize(next) {
+			return nil, ErrNoConvergence
+		}
+
+		if distance(x, next) < float64(n)*tolerance {
+			return c.values(next), nil
+		}
+		x = next
+	}
+
+	return nil, ErrNoConvergence
+}
+
+// KatzCentrality computes x = alpha * Aᵀx + beta by power iteration.
+// Alpha must be less than the reciprocal of the largest eigenvalue of the
+// adjacency matrix, otherwise the iteration diverges.
+// Result is normalized to unit euclidean length.
+// Returns ErrNoConvergence if the tolerance is not reached in maxIterations.
+func KatzCentrality[K comparable](g GraphReader[K], alpha, beta, tolerance float64, maxIterations int) (map[K]float64, error) {
+	c, err := newCompact(g)
+	if err != nil {
+		return nil, err
+	}
+
+	n := c.order()
+	if n == 0 {
+		return map[K]float64{}, nil
+	}
+
+	x := make([]float64, n)
+	for range maxIterations {
+		next := make([]float64, n)
+		for u, neighbors := range c.adj {
+			for _, v := range neighbors {
+				next[v] += x[u]
+			}
+		}
+		for i := range next {
+			next[i] = alpha*next[i] + beta
+		}
+
+		if distance(x, next) < float64(n)*tolerance {
+			if !normalize(next) {
+				return nil, ErrNoConvergence
+			}
+			return c.values(next), nil
+		}
+		x = next
+	}
+
+	return nil, ErrNoConvergence
+}
+
+// HITS computes hub and authority scores by power iteration.
+// Good hubs point to good authorities, good authorities are pointed to by
+// good hubs. Both results are normalized to sum up to one.
+// Returns ErrNoConvergence if the tolerance is not reached in maxIterations.
+func HITS[K comparable](g GraphReader[K], tolerance float64, maxIterations int) (hubs, authorities map[K]float64, err error) {
+	c, err := newCompact(g)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	n := c.order()
+	if n == 0 {
+		return map[K]float64{}, map[K]float64{}, nil
+	}
+
+	h := make([]float64, n)
+	for i := range h {
+		h[i] = 1 / float64(n)
+	}
+
+	for range maxIterations {
+		a := make([]float64, n)
+		for u, neighbors := range c.adj {
+			for _, v := range neighbors {
+				a[v] += h[u]
+			}
+		}
+
+		next := make([]float64, n)
+		for u, neighbors := range c.adj {
+			for _, v := range neighbors {
+				next[u] += a[v]
+			}
+		}
+
+		if !scaleBy(next, maxOf(next)) || !scaleBy(a, maxOf(a)) {
+			return nil, nil, ErrNoConvergence
+		}
+
+		if distance(h, next) < tolerance {
+			scaleBy(next, sumOf(next))
+			scaleBy(a, sumOf(a))
+			return c.values(next), c.values(a), nil
+		}
+		h = next
+	}
+
+	return nil, nil, ErrNoConvergence
+}
+
+// normalize scales x to unit euclidean length.
+// Returns false if x is a zero vector.
+func normalize(x []float64) bool {
+	var sum float64
+	for _, value := range x {
+		sum += value * value
+	}
+	return scaleBy(x, math.Sqrt(sum))
+}
+
+func scaleBy(x []float64, by float64) bool {
+	if by == 0 {
+		return false
+	}
+	for i := range x {
+		x[i] /= by
+	}
+	return true
+}
+
+func maxOf(x []float64) float64 {
+	var m float64
+	for _, value := range x {
+		m = max(m, value)
+	}
+	return m
+}
+
+func sumOf(x []float64) float64 {
+	var sum float64
+	for _, value := range x {
+		sum += value
+	}
+	return sum
+}
+
+// distance returns the manhattan distance between x and y.
+func distance(x, y []float64) float64 {
+	var d float64
+	for i := range x {
+		d += math.Abs(x[i] - y[i])
+	}
+	return d
+}
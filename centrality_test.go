@@ -0,0 +1,141 @@
+package graph_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/axseem/graph"
+)
+
+func equalScores(expect []float64, got map[uint]float64) bool {
+	if len(expect) != len(got) {
+		return false
+	}
+	for vertex, score := range expect {
+		if math.Abs(got[uint(vertex)]-score) > 1e-4 {
+			return false
+		}
+	}
+	return true
+}
+
+type centralityTest struct {
+	desc          string
+	order         uint
+	edges         [][2]uint
+	maxIterations int
+	output        []float64
+	err           error
+}
+
+func TestEigenvectorCentrality(t *testing.T) {
+	third := 1 / math.Sqrt(3)
+	testCases := []centralityTest{
+		{
+			desc:          "empty graph",
+			order:         0,
+			maxIterations: 100,
+			output:        []float64{},
+		},
+		{
+			desc:          "star",
+			order:         4,
+			edges:         undirected([][2]uint{{0, 1}, {0, 2}, {0, 3}}),
+			maxIterations: 100,
+			output:        []float64{math.Sqrt(0.5), 1 / math.Sqrt(6), 1 / math.Sqrt(6), 1 / math.Sqrt(6)},
+		},
+		{
+			desc:          "3 vertices cycled directed graph",
+			order:         3,
+			edges:         [][2]uint{{0, 1}, {1, 2}, {2, 0}},
+			maxIterations: 100,
+			output:        []float64{third, third, third},
+		},
+		{
+			desc:          "not enough iterations",
+			order:         4,
+			edges:         undirected([][2]uint{{0, 1}, {0, 2}, {0, 3}}),
+			maxIterations: 1,
+			err:           graph.ErrNoConvergence,
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := newIndexed(tC.order, tC.edges)
+
+			scores, err := graph.EigenvectorCentrality(g, 1e-9, tC.maxIterations)
+			if err != tC.err {
+				t.Fatalf("expected error: %v, got: %v", tC.err, err)
+			}
+			if err == nil && !equalScores(tC.output, scores) {
+				t.Errorf("expected: %v, got: %v", tC.output, scores)
+			}
+		})
+	}
+}
+
+func TestKatzCentrality(t *testing.T) {
+	third := 1 / math.Sqrt(3)
+	testCases := []centralityTest{
+		{
+			desc:          "3 vertices cycled undirected graph",
+			order:         3,
+			edges:         undirected([][2]uint{{0, 1}, {1, 2}, {2, 0}}),
+			maxIterations: 100,
+			output:        []float64{third, third, third},
+		},
+		{
+			desc:          "graph:0→1",
+			order:         2,
+			edges:         [][2]uint{{0, 1}},
+			maxIterations: 100,
+			// x0 = 1, x1 = 0.1*x0 + 1
+			output: []float64{1 / math.Sqrt(1+1.1*1.1), 1.1 / math.Sqrt(1+1.1*1.1)},
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := newIndexed(tC.order, tC.edges)
+
+			scores, err := graph.KatzCentrality(g, 0.1, 1, 1e-9, tC.maxIterations)
+			if err != tC.err {
+				t.Fatalf("expected error: %v, got: %v", tC.err, err)
+			}
+			if err == nil && !equalScores(tC.output, scores) {
+				t.Errorf("expected: %v, got: %v", tC.output, scores)
+			}
+		})
+	}
+
+	t.Run("diverging alpha", func(t *testing.T) {
+		g := newIndexed(3, undirected([][2]uint{{0, 1}, {1, 2}, {2, 0}}))
+
+		if _, err := graph.KatzCentrality(g, 1, 1, 1e-9, 1000); err != graph.ErrNoConvergence {
+			t.Errorf("expected: %v, got: %v", graph.ErrNoConvergence, err)
+		}
+	})
+}
+
+func TestHITS(t *testing.T) {
+	g := newIndexed(3, [][2]uint{{0, 1}, {0, 2}})
+
+	hubs, authorities, err := graph.HITS(g, 1e-9, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expect := []float64{1, 0, 0}; !equalScores(expect, hubs) {
+		t.Errorf("expected hubs: %v, got: %v", expect, hubs)
+	}
+	if expect := []float64{0, 0.5, 0.5}; !equalScores(expect, authorities) {
+		t.Errorf("expected authorities: %v, got: %v", expect, authorities)
+	}
+}
+
+func TestCentralityInfiniteGraph(t *testing.T) {
+	if _, err := graph.EigenvectorCentrality(graph.NewGrid(), 1e-9, 100); err != graph.ErrInfiniteGraph {
+		t.Errorf("expected: %v, got: %v", graph.ErrInfiniteGraph, err)
+	}
+}
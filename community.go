@@ -0,0 +1,304 @@
+package graph
+
+import (
+	"math/rand/v2"
+	"slices"
+)
+
+// Modularity measures how much denser the edges inside communities are
+// compared to a random graph with the same degrees. The graph is treated
+// as undirected, an edge present in both directions is counted once.
+// The partition maps every vertex to a community id in range [0, communities).
+func Modularity[K comparable](g GraphReader[K], partition map[K]int) (float64, error) {
+	c, err := newCompact(g)
+	if err != nil {
+		return 0, err
+	}
+
+	communities := make([]int, c.order())
+	for i, vertex := range c.vertices {
+		community, ok := partition[vertex]
+		if !ok {
+			return 0, ErrPartition
+		}
+		communities[i] = community
+	}
+
+	return modularity(weigh(c.undirected()), communities, 1), nil
+}
+
+// Louvain finds communities by greedily moving vertices between communities
+// while modularity grows, then collapsing communities into single vertices
+// and repeating on the smaller graph.
+// Resolution above one favours smaller communities, below one larger ones.
+// Returns a partition of the form Modularity takes.
+// If rng is nil, a randomly seeded generator is used. A seed reproduces
+// the result only if Vertices of the graph keep their order between calls,
+// as they do in Indexed but not in Mapped.
+func Louvain[K comparable](g GraphReader[K], resolution float64, rng *rand.Rand) (map[K]int, error) {
+	c, err := newCompact(g)
+	if err != nil {
+		return nil, err
+	}
+	if rng == nil {
+		rng = rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	}
+
+	adj := weigh(c.undirected())
+	communities := make([]int, c.order())
+	for i := range communities {
+		communities[i] = i
+	}
+
+	for {
+		level, moved := louvainMove(adj, resolution, rng)
+		if !moved {
+			break
+		}
+
+		for i := range communities {
+			communities[i] = level[communities[i]]
+		}
+		adj = aggregate(adj, level)
+	}
+
+	return partition(c, communities), nil
+}
+
+// LabelPropagation finds communities by letting every vertex adopt the label
+// most frequent among its neighbors, in random order, until labels settle.
+// Neighbors are taken along edges in both directions.
+// Returns a partition of the form Modularity takes.
+// If rng is nil, a randomly seeded generator is used. A seed reproduces
+// the result only if Vertices of the graph keep their order between calls,
+// as they do in Indexed but not in Mapped.
+func LabelPropagation[K comparable](g GraphReader[K], rng *rand.Rand) (map[K]int, error) {
+	c, err := newCompact(g)
+	if err != nil {
+		return nil, err
+	}
+	if rng == nil {
+		rng = rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	}
+
+	adj := c.undirected()
+	n := c.order()
+	labels := make([]int, n)
+	order := make([]int, n)
+	for i := range labels {
+		labels[i] = i
+		order[i] = i
+	}
+
+	counts := make([]int, n)
+	var best []int
+	for changed := true; changed; {
+		changed = false
+		rng.Shuffle(n, func(i, j int) { order[i], order[j] = order[j], order[i] })
+
+		for _, u := range order {
+			if len(adj[u]) == 0 {
+				continue
+			}
+
+			var top int
+			for _, v := range adj[u] {
+				counts[labels[v]]++
+				top = max(top, counts[labels[v]])
+			}
+
+			best = best[:0]
+			for _, v := range adj[u] {
+				label := labels[v]
+				if counts[label] == top {
+					best = append(best, label)
+					// mark the label, so it is collected once
+					counts[label] = -1
+				}
+			}
+			for _, v := range adj[u] {
+				counts[labels[v]] = 0
+			}
+
+			keep := false
+			for _, label := range best {
+				if label == labels[u] {
+					keep = true
+					break
+				}
+			}
+			if !keep {
+				labels[u] = best[rng.IntN(len(best))]
+				changed = true
+			}
+		}
+	}
+
+	return partition(c, labels), nil
+}
+
+type weightedEdge struct {
+	to     int
+	weight float64
+}
+
+func weigh(adj [][]int) [][]weightedEdge {
+	weighted := make([][]weightedEdge, len(adj))
+	for u, neighbors := range adj {
+		weighted[u] = make([]weightedEdge, len(neighbors))
+		for i, v := range neighbors {
+			weighted[u][i] = weightedEdge{v, 1}
+		}
+	}
+	return weighted
+}
+
+// modularity expects symmetric adjacency,
+// where a loop of a collapsed community holds the weight of both directions.
+func modularity(adj [][]weightedEdge, communities []int, resolution float64) float64 {
+	var total float64
+	inside := make(map[int]float64)
+	degrees := make(map[int]float64)
+	for u, edges := range adj {
+		for _, e := range edges {
+			total += e.weight
+			degrees[communities[u]] += e.weight
+			if communities[u] == communities[e.to] {
+				inside[communities[u]] += e.weight
+			}
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+
+	var q float64
+	for community, degree := range degrees {
+		q += inside[community]/total - resolution*(degree/total)*(degree/total)
+	}
+	return q
+}
+
+// louvainMove runs the local moving phase.
+// Returns communities renumbered from zero and whether any vertex was moved.
+func louvainMove(adj [][]weightedEdge, resolution float64, rng *rand.Rand) ([]int, bool) {
+	n := len(adj)
+	communities := make([]int, n)
+	degrees := make([]float64, n)
+	totals := make([]float64, n)
+	order := make([]int, n)
+
+	var total float64
+	for u, edges := range adj {
+		communities[u] = u
+		order[u] = u
+		for _, e := range edges {
+			degrees[u] += e.weight
+		}
+		totals[u] = degrees[u]
+		total += degrees[u]
+	}
+	if total == 0 {
+		return communities, false
+	}
+
+	links := make([]float64, n)
+	var touched []int
+	var moved bool
+	for improved := true; improved; {
+		improved = false
+		rng.Shuffle(n, func(i, j int) { order[i], order[j] = order[j], order[i] })
+
+		for _, u := range order {
+			current := communities[u]
+
+			touched = append(touched[:0], current)
+			links[current] = 0
+			for _, e := range adj[u] {
+				if e.to == u {
+					continue
+				}
+				community := communities[e.to]
+				if links[community] == 0 && community != current {
+					touched = append(touched, community)
+				}
+				links[community] += e.weight
+			}
+
+			totals[current] -= degrees[u]
+			best := current
+			bestGain := links[current] - resolution*totals[current]*degrees[u]/total
+			for _, community := range touched[1:] {
+				gain := links[community] - resolution*totals[community]*degrees[u]/total
+				if gain > bestGain {
+					best, bestGain = community, gain
+				}
+			}
+			totals[best] += degrees[u]
+
+			for _, community := range touched {
+				links[community] = 0
+			}
+
+			if best != current {
+				communities[u] = best
+				improved = true
+				moved = true
+			}
+		}
+	}
+
+	return renumber(communities), moved
+}
+
+// aggregate collapses every community into a single vertex.
+func aggregate(adj [][]weightedEdge, communities []int) [][]weightedEdge {
+	var n int
+	for _, community := range communities {
+		n = max(n, community+1)
+	}
+
+	weights := make([]map[int]float64, n)
+	for i := range weights {
+		weights[i] = make(map[int]float64)
+	}
+	for u, edges := range adj {
+		for _, e := range edges {
+			weights[communities[u]][communities[e.to]] += e.weight
+		}
+	}
+
+	collapsed := make([][]weightedEdge, n)
+	for u, neighbors := range weights {
+		for v, weight := range neighbors {
+			collapsed[u] = append(collapsed[u], weightedEdge{v, weight})
+		}
+		// map order is random, sorting keeps results reproducible for a seed
+		slices.SortFunc(collapsed[u], func(a, b weightedEdge) int {
+			return a.to - b.to
+		})
+	}
+	return collapsed
+}
+
+// renumber maps ids to range [0, amount of distinct ids) in order of appearance.
+func renumber(ids []int) []int {
+	seen := make(map[int]int)
+	result := make([]int, len(ids))
+	for i, id := range ids {
+		if _, ok := seen[id]; !ok {
+			seen[id] = len(seen)
+		}
+		result[i] = seen[id]
+	}
+	return result
+}
+
+func partition[K comparable](c *compact[K], communities []int) map[K]int {
+	communities = renumber(communities)
+	result := make(map[K]int, len(communities))
+	for i, community := range communities {
+		result[c.vertices[i]] = community
+	}
+	return result
+}
@@ -0,0 +1,191 @@
+package graph_test
+
+import (
+	"math"
+	"math/rand/v2"
+	"reflect"
+	"testing"
+
+	"github.com/axseem/graph"
+)
+
+// sameCommunities reports whether partition groups vertices exactly as expected.
+func sameCommunities(expect [][]uint, partition map[uint]int) bool {
+	ids := make(map[int]struct{})
+	for _, group := range expect {
+		id := partition[group[0]]
+		if _, ok := ids[id]; ok {
+			return false
+		}
+		ids[id] = struct{}{}
+
+		for _, vertex := range group {
+			if community, ok := partition[vertex]; !ok || community != id {
+				return false
+			}
+		}
+	}
+	return len(ids) == len(expect)
+}
+
+type communityTest struct {
+	desc   string
+	order  uint
+	edges  [][2]uint
+	seed   uint64
+	output [][]uint
+}
+
+func communityTests() []communityTest {
+	return []communityTest{
+		{
+			desc:   "null graph",
+			order:  3,
+			seed:   1,
+			output: [][]uint{{0}, {1}, {2}},
+		},
+		{
+			desc:   "two disconnected triangles",
+			order:  6,
+			edges:  undirected([][2]uint{{0, 1}, {1, 2}, {2, 0}, {3, 4}, {4, 5}, {5, 3}}),
+			seed:   1,
+			output: [][]uint{{0, 1, 2}, {3, 4, 5}},
+		},
+		{
+			desc:   "two disconnected directed cliques",
+			order:  8,
+			edges:  [][2]uint{{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3}, {4, 5}, {4, 6}, {4, 7}, {5, 6}, {5, 7}, {6, 7}},
+			seed:   7,
+			output: [][]uint{{0, 1, 2, 3}, {4, 5, 6, 7}},
+		},
+	}
+}
+
+func TestLouvain(t *testing.T) {
+	testCases := append(communityTests(), []communityTest{
+		{
+			desc:   "two triangles joined by edge",
+			order:  6,
+			edges:  undirected([][2]uint{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {4, 5}, {5, 3}}),
+			seed:   1,
+			output: [][]uint{{0, 1, 2}, {3, 4, 5}},
+		},
+		{
+			desc:  "ring of cliques",
+			order: 12,
+			edges: undirected([][2]uint{
+				{0, 1}, {0, 2}, {1, 2}, {3, 4}, {3, 5}, {4, 5},
+				{6, 7}, {6, 8}, {7, 8}, {9, 10}, {9, 11}, {10, 11},
+				{2, 3}, {5, 6}, {8, 9}, {11, 0},
+			}),
+			seed:   42,
+			output: [][]uint{{0, 1, 2}, {3, 4, 5}, {6, 7, 8}, {9, 10, 11}},
+		},
+	}...)
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := newIndexed(tC.order, tC.edges)
+
+			partition, err := graph.Louvain(g, 1, rand.New(rand.NewPCG(tC.seed, 0)))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !sameCommunities(tC.output, partition) {
+				t.Errorf("expected: %v, got: %v", tC.output, partition)
+			}
+		})
+	}
+}
+
+func TestLabelPropagation(t *testing.T) {
+	for _, tC := range communityTests() {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := newIndexed(tC.order, tC.edges)
+
+			partition, err := graph.LabelPropagation(g, rand.New(rand.NewPCG(tC.seed, 0)))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !sameCommunities(tC.output, partition) {
+				t.Errorf("expected: %v, got: %v", tC.output, partition)
+			}
+		})
+	}
+}
+
+func TestCommunitySeed(t *testing.T) {
+	// the ring is symmetric, so the outcome depends on the random order
+	g := newIndexed(12, undirected([][2]uint{
+		{0, 1}, {0, 2}, {1, 2}, {3, 4}, {3, 5}, {4, 5},
+		{6, 7}, {6, 8}, {7, 8}, {9, 10}, {9, 11}, {10, 11},
+		{2, 3}, {5, 6}, {8, 9}, {11, 0},
+	}))
+	detectors := map[string]func(rng *rand.Rand) (map[uint]int, error){
+		"louvain": func(rng *rand.Rand) (map[uint]int, error) {
+			return graph.Louvain(g, 1, rng)
+		},
+		"label propagation": func(rng *rand.Rand) (map[uint]int, error) {
+			return graph.LabelPropagation(g, rng)
+		},
+	}
+
+	for desc, detect := range detectors {
+		t.Run(desc, func(t *testing.T) {
+			first, err := detect(rand.New(rand.NewPCG(3, 0)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for range 10 {
+				second, err := detect(rand.New(rand.NewPCG(3, 0)))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(first, second) {
+					t.Fatalf("expected: %v, got: %v", first, second)
+				}
+			}
+		})
+	}
+}
+
+func TestModularity(t *testing.T) {
+	g := newIndexed(6, undirected([][2]uint{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {4, 5}, {5, 3}}))
+
+	testCases := []struct {
+		desc      string
+		partition map[uint]int
+		output    float64
+		err       error
+	}{
+		{
+			desc:      "single community",
+			partition: map[uint]int{0: 0, 1: 0, 2: 0, 3: 0, 4: 0, 5: 0},
+			output:    0,
+		},
+		{
+			desc:      "two triangles",
+			partition: map[uint]int{0: 0, 1: 0, 2: 0, 3: 1, 4: 1, 5: 1},
+			output:    2 * (6.0/14 - 0.25),
+		},
+		{
+			desc:      "incomplete partition",
+			partition: map[uint]int{0: 0},
+			err:       graph.ErrPartition,
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			q, err := graph.Modularity(g, tC.partition)
+			if err != tC.err {
+				t.Fatalf("expected error: %v, got: %v", tC.err, err)
+			}
+			if math.Abs(q-tC.output) > 1e-9 {
+				t.Errorf("expected: %v, got: %v", tC.output, q)
+			}
+		})
+	}
+}
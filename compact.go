@@ -0,0 +1,94 @@
+package graph
+
+// compact is a dense snapshot of a finite graph.
+// Every vertex is replaced by its position in the vertices slice,
+// so algorithms can keep their state in slices instead of maps.
+type compact[K comparable] struct {
+	vertices []K
+	index    map[K]int
+	adj      [][]int
+}
+
+func newCompact[K comparable](g GraphReader[K]) (*compact[K], error) {
+	if g.Order() < 0 {
+		return nil, ErrInfiniteGraph
+	}
+
+	vertices := g.Vertices()
+	c := &compact[K]{
+		vertices: vertices,
+		index:    make(map[K]int, len(vertices)),
+		adj:      make([][]int, len(vertices)),
+	}
+	for i, vertex := range vertices {
+		c.index[vertex] = i
+	}
+
+	for i, vertex := range vertices {
+		neighbors := g.Adjacency(vertex)
+		if neighbors == nil {
+			return nil, ErrNilVertex
+		}
+
+		c.adj[i] = make([]int, 0, len(neighbors))
+		for _, neighbor := range neighbors {
+			j, ok := c.index[neighbor]
+			if !ok {
+				return nil, ErrNilVertex
+			}
+			c.adj[i] = append(c.adj[i], j)
+		}
+	}
+
+	return c, nil
+}
+
+func (c *compact[K]) order() int {
+	return len(c.vertices)
+}
+
+// transpose returns the lists of incoming neighbors of every vertex.
+func (c *compact[K]) transpose() [][]int {
+	in := make([][]int, len(c.adj))
+	for u, neighbors := range c.adj {
+		for _, v := range neighbors {
+			in[v] = append(in[v], u)
+		}
+	}
+	return in
+}
+
+func (c *compact[K]) values(x []float64) map[K]float64 {
+	m := make(map[K]float64, len(x))
+	for i, value := range x {
+		m[c.vertices[i]] = value
+	}
+	return m
+}
+
+// undirected returns symmetric adjacency lists without loops and parallel edges.
+func (c *compact[K]) undirected() [][]int {
+	n := len(c.adj)
+	sym := make([][]int, n)
+	for u, neighbors := range c.adj {
+		for _, v := range neighbors {
+			if u != v {
+				sym[u] = append(sym[u], v)
+				sym[v] = append(sym[v], u)
+			}
+		}
+	}
+
+	seen := make([]int, n)
+	for u := range sym {
+		unique := sym[u][:0]
+		for _, v := range sym[u] {
+			if seen[v] != u+1 {
+				seen[v] = u + 1
+				unique = append(unique, v)
+			}
+		}
+		sym[u] = unique
+	}
+	return sym
+}
@@ -0,0 +1,171 @@
+package graph
+
+// CoreNumbers returns the core number of every vertex, the largest k such
+// that the vertex belongs to a subgraph where all degrees are at least k.
+// The second result is the degeneracy ordering, in which every vertex has
+// at most degeneracy neighbors that come after it. The graph is treated
+// as undirected, an edge present in both directions is counted once.
+func CoreNumbers[K comparable](g GraphReader[K]) (map[K]int, []K, error) {
+	c, err := newCompact(g)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	adj := c.undirected()
+	degrees := make([]int, len(adj))
+	for u, neighbors := range adj {
+		degrees[u] = len(neighbors)
+	}
+
+	p := newPeeler(degrees)
+	for _, v := range p.order {
+		for _, u := range adj[v] {
+			if p.values[u] > p.values[v] {
+				p.decrement(u)
+			}
+		}
+	}
+
+	cores := make(map[K]int, len(adj))
+	order := make([]K, len(adj))
+	for i, v := range p.order {
+		cores[c.vertices[v]] = p.values[v]
+		order[i] = c.vertices[v]
+	}
+	return cores, order, nil
+}
+
+// TrussNumbers returns the truss number of every edge, the largest k such
+// that the edge belongs to a subgraph where each edge is part of at least
+// k-2 triangles. Edges are read as in CoreNumbers and every edge
+// is present under both orientations.
+func TrussNumbers[K comparable](g GraphReader[K]) (map[[2]K]int, error) {
+	c, err := newCompact(g)
+	if err != nil {
+		return nil, err
+	}
+
+	adj := c.undirected()
+	ids := make(map[[2]int]int)
+	var edges [][2]int
+	for u, neighbors := range adj {
+		for _, v := range neighbors {
+			if u < v {
+				ids[[2]int{u, v}] = len(edges)
+				edges = append(edges, [2]int{u, v})
+			}
+		}
+	}
+	id := func(u, v int) int {
+		return ids[[2]int{min(u, v), max(u, v)}]
+	}
+
+	support := make([]int, len(edges))
+	for i, edge := range edges {
+		u, v := edge[0], edge[1]
+		if len(adj[u]) > len(adj[v]) {
+			u, v = v, u
+		}
+		for _, w := range adj[u] {
+			if _, ok := ids[[2]int{min(v, w), max(v, w)}]; ok {
+				support[i]++
+			}
+		}
+	}
+
+	p := newPeeler(support)
+	removed := make([]bool, len(edges))
+	for _, e := range p.order {
+		u, v := edges[e][0], edges[e][1]
+		if len(adj[u]) > len(adj[v]) {
+			u, v = v, u
+		}
+
+		for _, w := range adj[u] {
+			if _, ok := ids[[2]int{min(v, w), max(v, w)}]; !ok {
+				continue
+			}
+
+			uw, vw := id(u, w), id(v, w)
+			if removed[uw] || removed[vw] {
+				continue
+			}
+			if p.values[uw] > p.values[e] {
+				p.decrement(uw)
+			}
+			if p.values[vw] > p.values[e] {
+				p.decrement(vw)
+			}
+		}
+		removed[e] = true
+	}
+
+	truss := make(map[[2]K]int, len(edges)*2)
+	for e, edge := range edges {
+		u, v := c.vertices[edge[0]], c.vertices[edge[1]]
+		truss[[2]K{u, v}] = p.values[e] + 2
+		truss[[2]K{v, u}] = p.values[e] + 2
+	}
+	return truss, nil
+}
+
+// peeler keeps items ordered by value and allows decrementing the value of
+// an item in constant time, as described by Batagelj and Zaversnik.
+// Items are processed by walking through order, decrements are only allowed
+// for items whose value is greater than the value of the item being processed.
+type peeler struct {
+	values []int
+	order  []int
+	pos    []int
+	bins   []int
+}
+
+func newPeeler(values []int) *peeler {
+	var highest int
+	for _, value := range values {
+		highest = max(highest, value)
+	}
+
+	p := &peeler{
+		values: values,
+		order:  make([]int, len(values)),
+		pos:    make([]int, len(values)),
+		bins:   make([]int, highest+1),
+	}
+
+	for _, value := range values {
+		p.bins[value]++
+	}
+	start := 0
+	for value, count := range p.bins {
+		p.bins[value] = start
+		start += count
+	}
+	for i, value := range values {
+		p.pos[i] = p.bins[value]
+		p.order[p.pos[i]] = i
+		p.bins[value]++
+	}
+	for value := len(p.bins) - 1; value > 0; value-- {
+		p.bins[value] = p.bins[value-1]
+	}
+	if len(p.bins) > 0 {
+		p.bins[0] = 0
+	}
+
+	return p
+}
+
+// decrement moves the item to the beginning of its bin and shifts the bin
+// border, so the item becomes the last one of the previous bin.
+func (p *peeler) decrement(i int) {
+	value := p.values[i]
+	first := p.order[p.bins[value]]
+	if first != i {
+		p.pos[i], p.pos[first] = p.pos[first], p.pos[i]
+		p.order[p.pos[i]] = i
+		p.order[p.pos[first]] = first
+	}
+	p.bins[value]++
+	p.values[i]--
+}
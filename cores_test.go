@@ -0,0 +1,116 @@
+package graph_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/axseem/graph"
+)
+
+type decompositionTest struct {
+	desc  string
+	order uint
+	edges [][2]uint
+	cores map[uint]int
+	truss map[[2]uint]int
+}
+
+func decompositionTests() []decompositionTest {
+	return []decompositionTest{
+		{
+			desc:  "empty graph",
+			order: 0,
+			cores: map[uint]int{},
+			truss: map[[2]uint]int{},
+		},
+		{
+			desc:  "null graph",
+			order: 2,
+			cores: map[uint]int{0: 0, 1: 0},
+			truss: map[[2]uint]int{},
+		},
+		{
+			desc:  "path",
+			order: 3,
+			edges: [][2]uint{{0, 1}, {1, 2}},
+			cores: map[uint]int{0: 1, 1: 1, 2: 1},
+			truss: map[[2]uint]int{{0, 1}: 2, {1, 2}: 2},
+		},
+		{
+			desc:  "complete graph with pendant",
+			order: 5,
+			edges: undirected([][2]uint{{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3}, {3, 4}}),
+			cores: map[uint]int{0: 3, 1: 3, 2: 3, 3: 3, 4: 1},
+			truss: map[[2]uint]int{{0, 1}: 4, {0, 2}: 4, {0, 3}: 4, {1, 2}: 4, {1, 3}: 4, {2, 3}: 4, {3, 4}: 2},
+		},
+		{
+			desc:  "triangles sharing edge with tail",
+			order: 5,
+			edges: [][2]uint{{0, 1}, {1, 2}, {2, 0}, {1, 3}, {3, 2}, {3, 4}},
+			cores: map[uint]int{0: 2, 1: 2, 2: 2, 3: 2, 4: 1},
+			truss: map[[2]uint]int{{0, 1}: 3, {1, 2}: 3, {0, 2}: 3, {1, 3}: 3, {2, 3}: 3, {3, 4}: 2},
+		},
+	}
+}
+
+func TestCoreNumbers(t *testing.T) {
+	for _, tC := range decompositionTests() {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := newIndexed(tC.order, tC.edges)
+
+			cores, order, err := graph.CoreNumbers(g)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(tC.cores, cores) {
+				t.Errorf("expected: %v, got: %v", tC.cores, cores)
+			}
+
+			var degeneracy int
+			for _, core := range cores {
+				degeneracy = max(degeneracy, core)
+			}
+
+			position := make(map[uint]int)
+			for i, vertex := range order {
+				position[vertex] = i
+			}
+			for _, vertex := range order {
+				later := make(map[uint]struct{})
+				for _, edge := range tC.edges {
+					if edge[0] == vertex && position[edge[1]] > position[vertex] {
+						later[edge[1]] = struct{}{}
+					}
+					if edge[1] == vertex && position[edge[0]] > position[vertex] {
+						later[edge[0]] = struct{}{}
+					}
+				}
+				if len(later) > degeneracy {
+					t.Errorf("vertex %d has %d later neighbors in %v", vertex, len(later), order)
+				}
+			}
+		})
+	}
+}
+
+func TestTrussNumbers(t *testing.T) {
+	for _, tC := range decompositionTests() {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := newIndexed(tC.order, tC.edges)
+
+			truss, err := graph.TrussNumbers(g)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expect := make(map[[2]uint]int)
+			for edge, k := range tC.truss {
+				expect[edge] = k
+				expect[[2]uint{edge[1], edge[0]}] = k
+			}
+			if !reflect.DeepEqual(expect, truss) {
+				t.Errorf("expected: %v, got: %v", expect, truss)
+			}
+		})
+	}
+}
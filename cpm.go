@@ -0,0 +1,134 @@
+package graph
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Schedule is the result of the critical path method.
+type Schedule[K comparable, N Number] struct {
+	// Earliest time every activity can start at.
+	EarliestStart map[K]N
+	// Earliest time every activity can finish at.
+	EarliestFinish map[K]N
+	// Latest time every activity can start at without delaying the project.
+	LatestStart map[K]N
+	// Latest time every activity can finish at without delaying the project.
+	LatestFinish map[K]N
+	// Amount of time every activity can be delayed by without delaying the project.
+	Slack map[K]N
+	// Time the whole project takes.
+	Duration N
+	// Activities without slack from the first one to the last one to finish,
+	// each one starts as soon as the previous one finishes and its lag passes.
+	// Delaying any of them delays the project.
+	CriticalPath []K
+}
+
+// CriticalPath schedules activities of a project, where an edge from one
+// activity to another means the former has to finish before the latter starts.
+// Vertex values are durations of activities and edge values are lags,
+// the least time between the end of one activity and the start of another.
+// Activities on arrows are expressed with edge values and zero vertex values.
+// Values must not be negative, cycles are reported as ErrCycle.
+func CriticalPath[K comparable, N Number](g WeightedGraphReader[K, N]) (*Schedule[K, N], error) {
+	c, err := newCompact(g)
+	if err != nil {
+		return nil, err
+	}
+	if cycle := anyCycle(c.adj); cycle != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCycle, c.cycle(cycle))
+	}
+
+	n := c.order()
+	durations := g.VerticesValues(c.vertices...)
+	if len(durations) < n {
+		return nil, ErrNilVertex
+	}
+	lags := make([][]N, n)
+	for u, vertex := range c.vertices {
+		if durations[u] < 0 {
+			return nil, ErrNegativeWeight
+		}
+		if lags[u], err = edgeWeights(g, vertex, g.Adjacency(vertex)); err != nil {
+			return nil, err
+		}
+	}
+
+	// activities are sorted so that every one comes after its predecessors
+	preds := c.transpose()
+	remaining := make([]int, n)
+	var order []int
+	for v := range n {
+		remaining[v] = len(preds[v])
+		if remaining[v] == 0 {
+			order = append(order, v)
+		}
+	}
+	for i := 0; i < len(order); i++ {
+		for _, v := range c.adj[order[i]] {
+			remaining[v]--
+			if remaining[v] == 0 {
+				order = append(order, v)
+			}
+		}
+	}
+
+	// via holds the predecessor that delays the start of every activity,
+	// the last activity to finish is the end of the critical path
+	start := make([]N, n)
+	finish := make([]N, n)
+	via := make([]int, n)
+	for v := range via {
+		via[v] = -1
+	}
+	var duration N
+	last := -1
+	for _, u := range order {
+		finish[u] = start[u] + durations[u]
+		if last == -1 || finish[u] > duration {
+			duration, last = finish[u], u
+		}
+		for i, v := range c.adj[u] {
+			if ready := finish[u] + lags[u][i]; via[v] == -1 || ready > start[v] {
+				start[v], via[v] = ready, u
+			}
+		}
+	}
+
+	latestStart := make([]N, n)
+	latestFinish := make([]N, n)
+	for i := n - 1; i >= 0; i-- {
+		u := order[i]
+		latestFinish[u] = duration
+		for j, v := range c.adj[u] {
+			latestFinish[u] = min(latestFinish[u], latestStart[v]-lags[u][j])
+		}
+		latestStart[u] = latestFinish[u] - durations[u]
+	}
+
+	s := &Schedule[K, N]{
+		EarliestStart:  make(map[K]N, n),
+		EarliestFinish: make(map[K]N, n),
+		LatestStart:    make(map[K]N, n),
+		LatestFinish:   make(map[K]N, n),
+		Slack:          make(map[K]N, n),
+		Duration:       duration,
+		CriticalPath:   []K{},
+	}
+	for v, vertex := range c.vertices {
+		s.EarliestStart[vertex] = start[v]
+		s.EarliestFinish[vertex] = finish[v]
+		s.LatestStart[vertex] = latestStart[v]
+		s.LatestFinish[vertex] = latestFinish[v]
+		// rounding of float values must not make slack negative
+		s.Slack[vertex] = max(latestStart[v], start[v]) - start[v]
+	}
+
+	for v := last; v != -1; v = via[v] {
+		s.CriticalPath = append(s.CriticalPath, c.vertices[v])
+	}
+	slices.Reverse(s.CriticalPath)
+
+	return s, nil
+}
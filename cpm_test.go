@@ -0,0 +1,113 @@
+package graph_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/axseem/graph"
+)
+
+func TestCriticalPath(t *testing.T) {
+	testCases := []struct {
+		desc      string
+		durations map[string]int
+		edges     []edge[string, int]
+		output    graph.Schedule[string, int]
+	}{
+		{
+			desc:      "empty project",
+			durations: map[string]int{},
+			output: graph.Schedule[string, int]{
+				EarliestStart:  map[string]int{},
+				EarliestFinish: map[string]int{},
+				LatestStart:    map[string]int{},
+				LatestFinish:   map[string]int{},
+				Slack:          map[string]int{},
+				CriticalPath:   []string{},
+			},
+		},
+		{
+			desc:      "release checklist",
+			durations: map[string]int{"freeze": 2, "test": 5, "docs": 3, "tag": 1, "announce": 1},
+			edges: []edge[string, int]{
+				{"freeze", "test", 0}, {"freeze", "docs", 0}, {"test", "tag", 0},
+				{"docs", "tag", 0}, {"tag", "announce", 1}, {"docs", "announce", 0},
+			},
+			output: graph.Schedule[string, int]{
+				EarliestStart:  map[string]int{"freeze": 0, "test": 2, "docs": 2, "tag": 7, "announce": 9},
+				EarliestFinish: map[string]int{"freeze": 2, "test": 7, "docs": 5, "tag": 8, "announce": 10},
+				LatestStart:    map[string]int{"freeze": 0, "test": 2, "docs": 4, "tag": 7, "announce": 9},
+				LatestFinish:   map[string]int{"freeze": 2, "test": 7, "docs": 7, "tag": 8, "announce": 10},
+				Slack:          map[string]int{"freeze": 0, "test": 0, "docs": 2, "tag": 0, "announce": 0},
+				Duration:       10,
+				CriticalPath:   []string{"freeze", "test", "tag", "announce"},
+			},
+		},
+		{
+			desc:      "activities on arrows",
+			durations: map[string]int{"a": 0, "b": 0, "c": 0, "d": 0},
+			edges:     []edge[string, int]{{"a", "b", 3}, {"a", "c", 2}, {"b", "d", 4}, {"c", "d", 6}},
+			output: graph.Schedule[string, int]{
+				EarliestStart:  map[string]int{"a": 0, "b": 3, "c": 2, "d": 8},
+				EarliestFinish: map[string]int{"a": 0, "b": 3, "c": 2, "d": 8},
+				LatestStart:    map[string]int{"a": 0, "b": 4, "c": 2, "d": 8},
+				LatestFinish:   map[string]int{"a": 0, "b": 4, "c": 2, "d": 8},
+				Slack:          map[string]int{"a": 0, "b": 1, "c": 0, "d": 0},
+				Duration:       8,
+				CriticalPath:   []string{"a", "c", "d"},
+			},
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			var vertices []string
+			for vertex := range tC.durations {
+				vertices = append(vertices, vertex)
+			}
+			g := newWeighted(vertices, tC.edges)
+			g.vertices = tC.durations
+
+			s, err := graph.CriticalPath(g)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(tC.output, *s) {
+				t.Errorf("expected: %+v, got: %+v", tC.output, *s)
+			}
+		})
+	}
+}
+
+func TestCriticalPathErrors(t *testing.T) {
+	cyclic := newWeighted([]string{"a", "b"}, []edge[string, int]{{"a", "b", 0}, {"b", "a", 0}})
+	if _, err := graph.CriticalPath(cyclic); !errors.Is(err, graph.ErrCycle) {
+		t.Errorf("expected: %v, got: %v", graph.ErrCycle, err)
+	}
+
+	negative := newWeighted([]string{"a", "b"}, []edge[string, int]{{"a", "b", 0}})
+	negative.vertices["a"] = -1
+	if _, err := graph.CriticalPath(negative); err != graph.ErrNegativeWeight {
+		t.Errorf("expected: %v, got: %v", graph.ErrNegativeWeight, err)
+	}
+}
+
+func TestCriticalPathFloat(t *testing.T) {
+	g := newWeighted([]string{"a", "b", "c", "d"}, []edge[string, float64]{
+		{"a", "b", 0}, {"a", "c", 0}, {"b", "d", 0}, {"c", "d", 0},
+	})
+	g.vertices = map[string]float64{"a": 0.1, "b": 0.2, "c": 0.3, "d": 0.7}
+
+	s, err := graph.CriticalPath(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"a", "c", "d"}; !reflect.DeepEqual(expected, s.CriticalPath) {
+		t.Errorf("expected: %v, got: %v", expected, s.CriticalPath)
+	}
+	for vertex, slack := range s.Slack {
+		if slack < 0 {
+			t.Errorf("expected non-negative slack of %v, got: %v", vertex, slack)
+		}
+	}
+}
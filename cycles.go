@@ -0,0 +1,190 @@
+package graph
+
+import "slices"
+
+// ElementaryCycles passes every cycle without repeated vertices to while
+// using Johnson's algorithm, the function exits if while returns false.
+// A cycle is listed once, starting from its vertex that comes first
+// in the order of Vertices, the edge back to it is implied.
+// Passed slices may be kept. Every vertex pushed onto the search path
+// counts as a visit for the budget.
+func ElementaryCycles[K comparable](g GraphReader[K], while func(cycle []K) bool, opts ...SearchOptions) error {
+	b := newBudget(opts)
+	c, err := newCompact(g)
+	if err != nil {
+		return err
+	}
+
+	n := c.order()
+	blocked := make([]bool, n)
+	blockedBy := make([][]int, n)
+
+	// unblock releases the vertex along with vertices waiting for it
+	unblock := func(u int) {
+		blocked[u] = false
+		stack := []int{u}
+		for len(stack) > 0 {
+			v := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for _, w := range blockedBy[v] {
+				if blocked[w] {
+					blocked[w] = false
+					stack = append(stack, w)
+				}
+			}
+			blockedBy[v] = blockedBy[v][:0]
+		}
+	}
+
+	type frame struct {
+		vertex int
+		next   int
+		found  bool
+	}
+
+	// cycles through s are searched in the strong component of s
+	// among vertices that do not come before it
+	for s := 0; s < n; s++ {
+		scc := strongComponents(c.adj, s)
+		inside := func(v int) bool {
+			return v >= s && scc[v] == scc[s]
+		}
+		for v := s; v < n; v++ {
+			blocked[v] = false
+			blockedBy[v] = blockedBy[v][:0]
+		}
+
+		if err := b.visit(); err != nil {
+			return err
+		}
+		path := []int{s}
+		stack := []frame{{vertex: s}}
+		blocked[s] = true
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+
+			if top.next < len(c.adj[top.vertex]) {
+				w := c.adj[top.vertex][top.next]
+				top.next++
+				if !inside(w) {
+					continue
+				}
+
+				if w == s {
+					top.found = true
+					cycle := make([]K, len(path))
+					for i, v := range path {
+						cycle[i] = c.vertices[v]
+					}
+					if !while(cycle) {
+						return nil
+					}
+				} else if !blocked[w] {
+					if err := b.visit(); err != nil {
+						return err
+					}
+					path = append(path, w)
+					stack = append(stack, frame{vertex: w})
+					blocked[w] = true
+				}
+				continue
+			}
+
+			// vertex stays blocked until a vertex it leads to gets unblocked
+			v, found := top.vertex, top.found
+			if found {
+				unblock(v)
+			} else {
+				for _, w := range c.adj[v] {
+					if inside(w) && !slices.Contains(blockedBy[w], v) {
+						blockedBy[w] = append(blockedBy[w], v)
+					}
+				}
+			}
+			path = path[:len(path)-1]
+			stack = stack[:len(stack)-1]
+			if found && len(stack) > 0 {
+				stack[len(stack)-1].found = true
+			}
+		}
+	}
+
+	return nil
+}
+
+// strongComponents labels strongly connected components using Tarjan's
+// algorithm, only vertices from the given one onwards are considered,
+// the others are labeled -1. Components are numbered in reverse
+// topological order, a component never leads to one with a greater label.
+func strongComponents(adj [][]int, from int) []int {
+	n := len(adj)
+	index := make([]int, n)
+	low := make([]int, n)
+	onStack := make([]bool, n)
+	labels := make([]int, n)
+	for i := range labels {
+		index[i] = -1
+		labels[i] = -1
+	}
+
+	type frame struct {
+		vertex int
+		next   int
+	}
+	var counter, label int
+	var stack []int
+
+	for root := from; root < n; root++ {
+		if index[root] != -1 {
+			continue
+		}
+
+		index[root], low[root] = counter, counter
+		counter++
+		stack = append(stack, root)
+		onStack[root] = true
+		frames := []frame{{vertex: root}}
+
+		for len(frames) > 0 {
+			top := &frames[len(frames)-1]
+			v := top.vertex
+
+			if top.next < len(adj[v]) {
+				w := adj[v][top.next]
+				top.next++
+				switch {
+				case w < from:
+				case index[w] == -1:
+					index[w], low[w] = counter, counter
+					counter++
+					stack = append(stack, w)
+					onStack[w] = true
+					frames = append(frames, frame{vertex: w})
+				case onStack[w]:
+					low[v] = min(low[v], index[w])
+				}
+				continue
+			}
+
+			if low[v] == index[v] {
+				for {
+					w := stack[len(stack)-1]
+					stack = stack[:len(stack)-1]
+					onStack[w] = false
+					labels[w] = label
+					if w == v {
+						break
+					}
+				}
+				label++
+			}
+			frames = frames[:len(frames)-1]
+			if len(frames) > 0 {
+				parent := frames[len(frames)-1].vertex
+				low[parent] = min(low[parent], low[v])
+			}
+		}
+	}
+
+	return labels
+}
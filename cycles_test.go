@@ -0,0 +1,157 @@
+package graph_test
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/axseem/graph"
+)
+
+func TestElementaryCycles(t *testing.T) {
+	complete := func(order uint) [][2]uint {
+		var edges [][2]uint
+		for i := range order {
+			for j := range order {
+				if i != j {
+					edges = append(edges, [2]uint{i, j})
+				}
+			}
+		}
+		return edges
+	}
+
+	testCases := []struct {
+		desc   string
+		order  uint
+		edges  [][2]uint
+		output [][]uint
+	}{
+		{
+			desc:  "empty graph",
+			order: 0,
+		},
+		{
+			desc:  "acyclic graph",
+			order: 3,
+			edges: [][2]uint{{0, 1}, {1, 2}, {0, 2}},
+		},
+		{
+			desc:   "single cycle",
+			order:  3,
+			edges:  [][2]uint{{0, 1}, {1, 2}, {2, 0}},
+			output: [][]uint{{0, 1, 2}},
+		},
+		{
+			desc:   "complete graph",
+			order:  3,
+			edges:  complete(3),
+			output: [][]uint{{0, 1}, {0, 1, 2}, {0, 2}, {0, 2, 1}, {1, 2}},
+		},
+		{
+			desc:   "separate components",
+			order:  5,
+			edges:  [][2]uint{{0, 1}, {1, 0}, {1, 2}, {2, 3}, {3, 4}, {4, 2}},
+			output: [][]uint{{0, 1}, {2, 3, 4}},
+		},
+		{
+			desc:   "shared vertex",
+			order:  4,
+			edges:  [][2]uint{{0, 1}, {1, 0}, {0, 2}, {2, 3}, {3, 0}},
+			output: [][]uint{{0, 1}, {0, 2, 3}},
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := newIndexed(tC.order, tC.edges)
+
+			var output [][]uint
+			err := graph.ElementaryCycles(g, func(cycle []uint) bool {
+				output = append(output, cycle)
+				return true
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			slices.SortFunc(output, slices.Compare)
+			if !reflect.DeepEqual(tC.output, output) {
+				t.Errorf("expected: %v, got: %v", tC.output, output)
+			}
+		})
+	}
+
+	t.Run("count in complete graph", func(t *testing.T) {
+		var count int
+		err := graph.ElementaryCycles(newIndexed(5, complete(5)), func(cycle []uint) bool {
+			count++
+			return true
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		// sum of C(5, k) * (k - 1)! for k from 2 to 5
+		if count != 84 {
+			t.Errorf("expected: %v, got: %v", 84, count)
+		}
+	})
+}
+
+func TestElementaryCyclesImports(t *testing.T) {
+	g := graph.NewMapped[string]()
+	if err := g.AddVertices("app", "auth", "db", "log", "user"); err != nil {
+		panic(err)
+	}
+	if err := g.AddEdges(
+		[2]string{"app", "auth"}, [2]string{"auth", "user"}, [2]string{"user", "auth"},
+		[2]string{"user", "db"}, [2]string{"db", "log"}, [2]string{"log", "user"},
+	); err != nil {
+		panic(err)
+	}
+
+	// cycles start from a vertex in map order, so they are rotated to the least one
+	var output [][]string
+	err := graph.ElementaryCycles(g, func(cycle []string) bool {
+		least := slices.Index(cycle, slices.Min(cycle))
+		output = append(output, append(cycle[least:], cycle[:least]...))
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	slices.SortFunc(output, slices.Compare)
+
+	expect := [][]string{{"auth", "user"}, {"db", "log", "user"}}
+	if !reflect.DeepEqual(expect, output) {
+		t.Errorf("expected: %v, got: %v", expect, output)
+	}
+}
+
+func TestElementaryCyclesStop(t *testing.T) {
+	var count int
+	err := graph.ElementaryCycles(newIndexed(3, undirected([][2]uint{{0, 1}, {1, 2}})), func(cycle []uint) bool {
+		count++
+		return false
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("expected: %v, got: %v", 1, count)
+	}
+}
+
+func TestElementaryCyclesBudget(t *testing.T) {
+	var output [][]uint
+	g := newIndexed(4, undirected([][2]uint{{0, 1}, {1, 2}, {2, 3}}))
+	err := graph.ElementaryCycles(g, func(cycle []uint) bool {
+		output = append(output, cycle)
+		return true
+	}, graph.SearchOptions{MaxVisited: 2})
+	if !errors.Is(err, graph.ErrBudgetExceeded) {
+		t.Errorf("expected: %v, got: %v", graph.ErrBudgetExceeded, err)
+	}
+	if expect := [][]uint{{0, 1}}; !reflect.DeepEqual(expect, output) {
+		t.Errorf("expected: %v, got: %v", expect, output)
+	}
+}
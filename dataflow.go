@@ -0,0 +1,105 @@
+package graph
+
+// Lattice describes values computed by a dataflow analysis.
+type Lattice[T any] interface {
+	// The least value, every vertex starts from it.
+	Bottom() T
+	// The least value that is not less than both of the given ones.
+	Join(a, b T) T
+	Equal(a, b T) bool
+}
+
+// Direction in which values flow along edges.
+type Direction int
+
+const (
+	// Values flow from predecessors to successors, as in reaching definitions.
+	Forward Direction = iota
+	// Values flow from successors to predecessors, as in liveness.
+	Backward
+)
+
+// Dataflow holds values before and after every vertex,
+// in the order of execution regardless of the direction.
+type Dataflow[K comparable, T any] struct {
+	In  map[K]T
+	Out map[K]T
+}
+
+// SolveDataflow computes the fixed point of a monotone dataflow analysis
+// with a worklist. Forward analysis joins Out of predecessors into In of
+// a vertex and passes it to transfer to get its Out, backward analysis joins
+// In of successors into Out and passes it to transfer to get In.
+// Transfer must be monotone and the lattice must have no infinite ascending
+// chains, each call of transfer counts as a visit for the budget.
+// When the budget runs out, values reached so far are returned with
+// ErrBudgetExceeded, they are not a fixed point yet.
+func SolveDataflow[K comparable, T any](g GraphReader[K], lattice Lattice[T], direction Direction, transfer func(vertex K, value T) T, opts ...SearchOptions) (*Dataflow[K, T], error) {
+	b := newBudget(opts)
+	c, err := newCompact(g)
+	if err != nil {
+		return nil, err
+	}
+
+	// values flow from sources into a vertex and from it into targets
+	sources, targets := c.transpose(), c.adj
+	if direction == Backward {
+		sources, targets = targets, sources
+	}
+
+	n := c.order()
+	before := make([]T, n)
+	after := make([]T, n)
+	queued := make([]bool, n)
+	queue := make([]int, n)
+	for v := range n {
+		before[v] = lattice.Bottom()
+		after[v] = lattice.Bottom()
+		queued[v] = true
+		queue[v] = v
+	}
+
+	// values computed so far are returned when the budget runs out
+	var exceeded error
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		queued[v] = false
+
+		value := lattice.Bottom()
+		for _, s := range sources[v] {
+			value = lattice.Join(value, after[s])
+		}
+		before[v] = value
+
+		if exceeded = b.visit(); exceeded != nil {
+			break
+		}
+		value = transfer(c.vertices[v], value)
+		if lattice.Equal(value, after[v]) {
+			continue
+		}
+		after[v] = value
+
+		for _, t := range targets[v] {
+			if !queued[t] {
+				queued[t] = true
+				queue = append(queue, t)
+			}
+		}
+	}
+
+	in, out := before, after
+	if direction == Backward {
+		in, out = out, in
+	}
+	d := &Dataflow[K, T]{
+		In:  make(map[K]T, n),
+		Out: make(map[K]T, n),
+	}
+	for v, vertex := range c.vertices {
+		d.In[vertex] = in[v]
+		d.Out[vertex] = out[v]
+	}
+	return d, exceeded
+}
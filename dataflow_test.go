@@ -0,0 +1,118 @@
+package graph_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/axseem/graph"
+)
+
+// bits is a lattice of sets stored as bits, joined by union.
+type bits struct{}
+
+func (bits) Bottom() uint         { return 0 }
+func (bits) Join(a, b uint) uint  { return a | b }
+func (bits) Equal(a, b uint) bool { return a == b }
+
+// loop is a function body where every vertex is a statement:
+//
+//	0: a = 1
+//	1: b = a + 1
+//	2: c = c + b
+//	3: a = b * 2
+//	4: if a < n goto 1
+//	5: return c
+func loop() *graph.Mapped[int] {
+	g := graph.NewMapped[int]()
+	if err := g.AddVertices(0, 1, 2, 3, 4, 5); err != nil {
+		panic(err)
+	}
+	if err := g.AddEdges([2]int{0, 1}, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 4}, [2]int{4, 1}, [2]int{4, 5}); err != nil {
+		panic(err)
+	}
+	return g
+}
+
+func TestSolveDataflowLiveness(t *testing.T) {
+	const a, b, c = 1, 2, 4
+	use := map[int]uint{1: a, 2: b | c, 3: b, 4: a, 5: c}
+	def := map[int]uint{0: a, 1: b, 2: c, 3: a}
+
+	d, err := graph.SolveDataflow(loop(), bits{}, graph.Backward, func(vertex int, live uint) uint {
+		return use[vertex] | live&^def[vertex]
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	in := map[int]uint{0: c, 1: a | c, 2: b | c, 3: b | c, 4: a | c, 5: c}
+	out := map[int]uint{0: a | c, 1: b | c, 2: b | c, 3: a | c, 4: a | c, 5: 0}
+	if !reflect.DeepEqual(in, d.In) || !reflect.DeepEqual(out, d.Out) {
+		t.Errorf("expected: %v %v, got: %v %v", in, out, d.In, d.Out)
+	}
+}
+
+func TestSolveDataflowReachingDefinitions(t *testing.T) {
+	// definitions are named after statements, a is defined twice
+	const d0, d1, d2, d3 = 1, 2, 4, 8
+	gen := map[int]uint{0: d0, 1: d1, 2: d2, 3: d3}
+	kill := map[int]uint{0: d3, 3: d0}
+
+	d, err := graph.SolveDataflow(loop(), bits{}, graph.Forward, func(vertex int, reaching uint) uint {
+		return gen[vertex] | reaching&^kill[vertex]
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	all := uint(d0 | d1 | d2 | d3)
+	in := map[int]uint{0: 0, 1: all, 2: all, 3: all, 4: d1 | d2 | d3, 5: d1 | d2 | d3}
+	out := map[int]uint{0: d0, 1: all, 2: all, 3: d1 | d2 | d3, 4: d1 | d2 | d3, 5: d1 | d2 | d3}
+	if !reflect.DeepEqual(in, d.In) || !reflect.DeepEqual(out, d.Out) {
+		t.Errorf("expected: %v %v, got: %v %v", in, out, d.In, d.Out)
+	}
+}
+
+func TestSolveDataflowBudget(t *testing.T) {
+	// counting up never reaches a fixed point
+	d, err := graph.SolveDataflow(loop(), bits{}, graph.Forward, func(vertex int, value uint) uint {
+		return value + 1
+	}, graph.SearchOptions{MaxVisited: 100})
+	if !errors.Is(err, graph.ErrBudgetExceeded) {
+		t.Errorf("expected: %v, got: %v", graph.ErrBudgetExceeded, err)
+	}
+	if d == nil || len(d.In) != 6 || len(d.Out) != 6 {
+		t.Fatalf("expected partial values of every vertex, got: %+v", d)
+	}
+	if d.Out[0] != 1 {
+		t.Errorf("expected: %v, got: %v", 1, d.Out[0])
+	}
+}
+
+// full is a lattice of sets stored as bits, joined by intersection,
+// so the bottom is the full set.
+type full struct{}
+
+func (full) Bottom() uint         { return ^uint(0) }
+func (full) Join(a, b uint) uint  { return a & b }
+func (full) Equal(a, b uint) bool { return a == b }
+
+func TestSolveDataflowPartialBottom(t *testing.T) {
+	// the same body as loop, vertices are processed in order
+	g := newIndexed(6, [][2]uint{{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 1}, {4, 5}})
+	d, err := graph.SolveDataflow(g, full{}, graph.Forward, func(vertex uint, value uint) uint {
+		return value &^ 1
+	}, graph.SearchOptions{MaxVisited: 1})
+	if !errors.Is(err, graph.ErrBudgetExceeded) {
+		t.Errorf("expected: %v, got: %v", graph.ErrBudgetExceeded, err)
+	}
+
+	// the cut comes before the transfer of the second vertex
+	all := ^uint(0)
+	in := map[uint]uint{0: all, 1: all &^ 1, 2: all, 3: all, 4: all, 5: all}
+	out := map[uint]uint{0: all &^ 1, 1: all, 2: all, 3: all, 4: all, 5: all}
+	if !reflect.DeepEqual(in, d.In) || !reflect.DeepEqual(out, d.Out) {
+		t.Errorf("expected: %v %v, got: %v %v", in, out, d.In, d.Out)
+	}
+}
@@ -0,0 +1,139 @@
+package graph
+
+// IDDFS finds a path with the least amount of edges from entry to any vertex
+// accepted by goal. It repeats depth limited DFS with a growing limit,
+// so memory usage stays proportional to the path length.
+// Only vertices of the current path are remembered,
+// the same vertex may be expanded many times.
+func IDDFS[K comparable](g Graph[K], entry K, goal func(vertex K) bool, opts ...SearchOptions) ([]K, error) {
+	unit := func(vertex K, neighbors []K) ([]uint, error) {
+		weights := make([]uint, len(neighbors))
+		for i := range weights {
+			weights[i] = 1
+		}
+		return weights, nil
+	}
+	zero := func(vertex K) uint { return 0 }
+
+	path, _, err := deepen(g, entry, goal, unit, zero, newBudget(opts))
+	return path, err
+}
+
+// IDAStar finds the cheapest path from entry to any vertex accepted by goal.
+// It repeats depth first search bounded by the estimated total cost,
+// raising the bound to the smallest estimate that exceeded it.
+// Heuristic must not overestimate the remaining cost to the goal.
+// Edge weights must not be negative.
+func IDAStar[K comparable, N Number](g WeightedGraph[K, N], entry K, goal func(vertex K) bool, heuristic func(vertex K) N, opts ...SearchOptions) (Path[K, N], error) {
+	weights := func(vertex K, neighbors []K) ([]N, error) {
+		return edgeWeights(g, vertex, neighbors)
+	}
+
+	path, cost, err := deepen(g, entry, goal, weights, heuristic, newBudget(opts))
+	if err != nil {
+		return Path[K, N]{}, err
+	}
+	return Path[K, N]{path, cost}, nil
+}
+
+// deepen runs bounded depth first searches with a growing bound.
+func deepen[K comparable, N Number](
+	g Graph[K],
+	entry K,
+	goal func(vertex K) bool,
+	weights func(vertex K, neighbors []K) ([]N, error),
+	heuristic func(vertex K) N,
+	b *budget,
+) ([]K, N, error) {
+	type frame struct {
+		vertex    K
+		cost      N
+		neighbors []K
+		weights   []N
+	}
+
+	bound := heuristic(entry)
+	for {
+		var next N
+		var exceeded, cut bool
+		stack := []frame{}
+		onPath := make(map[K]struct{})
+
+		// enter checks the vertex and pushes it on the stack to be expanded.
+		// Returns true if the vertex is a goal.
+		enter := func(vertex K, cost N) (bool, error) {
+			if estimate := cost + heuristic(vertex); estimate > bound {
+				if !exceeded || estimate < next {
+					next = estimate
+				}
+				exceeded = true
+				return false, nil
+			}
+			if !b.reaches(uint(len(stack))) {
+				cut = true
+				return false, nil
+			}
+			if err := b.visit(); err != nil {
+				return false, err
+			}
+
+			n := g.Adjacency(vertex)
+			if n == nil {
+				return false, ErrNilVertex
+			}
+
+			stack = append(stack, frame{vertex: vertex, cost: cost})
+			if goal(vertex) {
+				return true, nil
+			}
+
+			w, err := weights(vertex, n)
+			if err != nil {
+				return false, err
+			}
+			stack[len(stack)-1].neighbors = n
+			stack[len(stack)-1].weights = w
+			onPath[vertex] = struct{}{}
+			return false, nil
+		}
+
+		found, err := enter(entry, 0)
+		for !found && err == nil && len(stack) > 0 {
+			top := &stack[len(stack)-1]
+
+			if len(top.neighbors) == 0 {
+				delete(onPath, top.vertex)
+				stack = stack[:len(stack)-1]
+				continue
+			}
+			neighbor := top.neighbors[len(top.neighbors)-1]
+			weight := top.weights[len(top.weights)-1]
+			top.neighbors = top.neighbors[:len(top.neighbors)-1]
+			top.weights = top.weights[:len(top.weights)-1]
+
+			if _, ok := onPath[neighbor]; ok {
+				continue
+			}
+			found, err = enter(neighbor, top.cost+weight)
+		}
+
+		if err != nil {
+			return nil, 0, err
+		}
+		if found {
+			path := make([]K, len(stack))
+			for i, f := range stack {
+				path[i] = f.vertex
+			}
+			return path, stack[len(stack)-1].cost, nil
+		}
+
+		if !exceeded {
+			if cut {
+				return nil, 0, ErrBudgetExceeded
+			}
+			return nil, 0, ErrNoPath
+		}
+		bound = next
+	}
+}
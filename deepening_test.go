@@ -0,0 +1,151 @@
+package graph_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/axseem/graph"
+)
+
+// unitGrid is a Grid where every step costs one.
+type unitGrid struct {
+	*graph.Grid
+}
+
+func (g unitGrid) VerticesValues(vertices ...[2]int) []int {
+	return make([]int, len(vertices))
+}
+
+func (g unitGrid) EdgesValues(edges ...[2][2]int) []int {
+	values := make([]int, len(edges))
+	for i := range values {
+		values[i] = 1
+	}
+	return values
+}
+
+func manhattan(to [2]int) func(vertex [2]int) int {
+	return func(vertex [2]int) int {
+		dx, dy := vertex[0]-to[0], vertex[1]-to[1]
+		return max(dx, -dx) + max(dy, -dy)
+	}
+}
+
+func TestIDDFS(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		order uint
+		edges [][2]uint
+		goal  uint
+		path  []uint
+		err   error
+	}{
+		{
+			desc:  "empty graph",
+			order: 0,
+			err:   graph.ErrNilVertex,
+		},
+		{
+			desc:  "trivial graph",
+			order: 1,
+			goal:  0,
+			path:  []uint{0},
+		},
+		{
+			desc:  "graph:0←1",
+			order: 2,
+			edges: [][2]uint{{1, 0}},
+			goal:  1,
+			err:   graph.ErrNoPath,
+		},
+		{
+			desc:  "3 vertices cycled directed graph",
+			order: 3,
+			edges: [][2]uint{{0, 1}, {1, 2}, {2, 0}},
+			goal:  2,
+			path:  []uint{0, 1, 2},
+		},
+		{
+			desc:  "shortest of two branches",
+			order: 5,
+			edges: [][2]uint{{0, 3}, {3, 4}, {0, 1}, {1, 2}, {2, 4}},
+			goal:  4,
+			path:  []uint{0, 3, 4},
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := newIndexed(tC.order, tC.edges)
+
+			path, err := graph.IDDFS(g, 0, func(vertex uint) bool {
+				return vertex == tC.goal
+			})
+			if err != tC.err {
+				t.Fatalf("expected error: %v, got: %v", tC.err, err)
+			}
+			if !reflect.DeepEqual(tC.path, path) {
+				t.Errorf("expected: %v, got: %v", tC.path, path)
+			}
+		})
+	}
+}
+
+func TestIDDFSGrid(t *testing.T) {
+	g := graph.NewGrid()
+	goal := func(vertex [2]int) bool { return vertex == [2]int{2, 1} }
+
+	path, err := graph.IDDFS(g, [2]int{0, 0}, goal)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(path) != 4 || path[3] != [2]int{2, 1} {
+		t.Errorf("expected path of 4 vertices, got: %v", path)
+	}
+
+	if _, err := graph.IDDFS(g, [2]int{0, 0}, goal, graph.SearchOptions{MaxDepth: 2}); err != graph.ErrBudgetExceeded {
+		t.Errorf("expected: %v, got: %v", graph.ErrBudgetExceeded, err)
+	}
+}
+
+func TestIDAStar(t *testing.T) {
+	for _, tC := range pathTests() {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := newWeighted(tC.vertices, tC.edges)
+
+			path, err := graph.IDAStar(g, tC.from, func(vertex string) bool {
+				return vertex == tC.to
+			}, func(vertex string) int {
+				return 0
+			})
+			if err != tC.err {
+				t.Fatalf("expected error: %v, got: %v", tC.err, err)
+			}
+			if !reflect.DeepEqual(tC.path, path.Vertices) || tC.cost != path.Cost {
+				t.Errorf("expected: %v %v, got: %v %v", tC.path, tC.cost, path.Vertices, path.Cost)
+			}
+		})
+	}
+}
+
+func TestIDAStarGrid(t *testing.T) {
+	g := unitGrid{graph.NewGrid()}
+	// wall between the start and the goal
+	g.DeleteVertices([2]int{3, -1}, [2]int{3, 0}, [2]int{3, 1})
+
+	to := [2]int{6, 0}
+	goal := func(vertex [2]int) bool { return vertex == to }
+
+	path, err := graph.IDAStar[[2]int, int](g, [2]int{0, 0}, goal, manhattan(to))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path.Cost != 10 || len(path.Vertices) != 11 {
+		t.Errorf("expected cost 10 around the wall, got: %v", path)
+	}
+
+	_, err = graph.IDAStar[[2]int, int](g, [2]int{0, 0}, goal, manhattan(to), graph.SearchOptions{MaxVisited: 10})
+	if err != graph.ErrBudgetExceeded {
+		t.Errorf("expected: %v, got: %v", graph.ErrBudgetExceeded, err)
+	}
+}
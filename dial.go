@@ -0,0 +1,164 @@
+package graph
+
+// ZeroOneBFS finds the cheapest path in a graph whose edge weights are
+// either zero or one, in linear time. Vertices reached through zero weight
+// edges are explored before the others, using a double ended queue.
+// Other weights result in ErrWeightRange.
+func ZeroOneBFS[K comparable, N Integer](g WeightedGraph[K, N], from, to K, opts ...SearchOptions) (Path[K, N], error) {
+	s := newIntegerSearch(g, from, opts)
+
+	// front is a stack and back is a queue, together they form a deque
+	front := []queueItem[K, N]{{vertex: from}}
+	var back []queueItem[K, N]
+	for len(front) > 0 || len(back) > 0 {
+		var top queueItem[K, N]
+		if len(front) > 0 {
+			top = front[len(front)-1]
+			front = front[:len(front)-1]
+		} else {
+			top = back[0]
+			back = back[1:]
+		}
+
+		done, err := s.settle(top, to, func(item queueItem[K, N], weight N) error {
+			switch weight {
+			case 0:
+				front = append(front, item)
+			case 1:
+				back = append(back, item)
+			default:
+				return ErrWeightRange
+			}
+			return nil
+		})
+		if done || err != nil {
+			return s.path, err
+		}
+	}
+
+	return s.finish()
+}
+
+// maxDialWeight bounds the amount of buckets Dial allocates.
+const maxDialWeight = 1 << 20
+
+// Dial finds the cheapest path in a graph whose edge weights are small
+// integers in range [0, maxWeight]. The priority queue is replaced with
+// a circular array of buckets, one per cost. Weights above maxWeight
+// result in ErrWeightRange, as does maxWeight above 1<<20,
+// since every unit of it takes a bucket.
+func Dial[K comparable, N Integer](g WeightedGraph[K, N], from, to K, maxWeight N, opts ...SearchOptions) (Path[K, N], error) {
+	if maxWeight < 0 {
+		return Path[K, N]{}, ErrNegativeWeight
+	}
+	if uint64(maxWeight) > maxDialWeight {
+		return Path[K, N]{}, ErrWeightRange
+	}
+
+	s := newIntegerSearch(g, from, opts)
+	buckets := make([][]queueItem[K, N], int(maxWeight)+1)
+	buckets[0] = []queueItem[K, N]{{vertex: from}}
+	pending := 1
+
+	for cost := 0; pending > 0; cost++ {
+		bucket := &buckets[cost%len(buckets)]
+		for len(*bucket) > 0 {
+			top := (*bucket)[len(*bucket)-1]
+			*bucket = (*bucket)[:len(*bucket)-1]
+			pending--
+
+			done, err := s.settle(top, to, func(item queueItem[K, N], weight N) error {
+				if weight > maxWeight {
+					return ErrWeightRange
+				}
+				next := &buckets[int(item.cost)%len(buckets)]
+				*next = append(*next, item)
+				pending++
+				return nil
+			})
+			if done || err != nil {
+				return s.path, err
+			}
+		}
+	}
+
+	return s.finish()
+}
+
+// integerSearch holds the state shared by label correcting searches
+// that differ only in the way they queue vertices.
+type integerSearch[K comparable, N Integer] struct {
+	g       WeightedGraph[K, N]
+	budget  *budget
+	from    K
+	dist    map[K]N
+	hops    map[K]uint
+	parents map[K]K
+	settled map[K]struct{}
+	cut     bool
+	path    Path[K, N]
+}
+
+func newIntegerSearch[K comparable, N Integer](g WeightedGraph[K, N], from K, opts []SearchOptions) *integerSearch[K, N] {
+	return &integerSearch[K, N]{
+		g:       g,
+		budget:  newBudget(opts),
+		from:    from,
+		dist:    map[K]N{from: 0},
+		hops:    map[K]uint{from: 0},
+		parents: make(map[K]K),
+		settled: make(map[K]struct{}),
+	}
+}
+
+// settle expands the vertex unless it was already settled,
+// improved neighbors are passed to push along with the edge weight.
+// Returns true once the target is reached.
+func (s *integerSearch[K, N]) settle(top queueItem[K, N], to K, push func(item queueItem[K, N], weight N) error) (bool, error) {
+	if _, ok := s.settled[top.vertex]; ok || top.cost != s.dist[top.vertex] {
+		return false, nil
+	}
+	if !s.budget.reaches(s.hops[top.vertex]) {
+		s.cut = true
+		return false, nil
+	}
+	if err := s.budget.visit(); err != nil {
+		return false, err
+	}
+	s.settled[top.vertex] = struct{}{}
+
+	if top.vertex == to {
+		s.path = Path[K, N]{tracePath(s.parents, s.from, to), top.cost}
+		return true, nil
+	}
+
+	n := s.g.Adjacency(top.vertex)
+	if n == nil {
+		return false, ErrNilVertex
+	}
+	weights, err := edgeWeights(s.g, top.vertex, n)
+	if err != nil {
+		return false, err
+	}
+
+	for i, neighbor := range n {
+		cost := top.cost + weights[i]
+		if old, ok := s.dist[neighbor]; ok && old <= cost {
+			continue
+		}
+		s.dist[neighbor] = cost
+		s.hops[neighbor] = s.hops[top.vertex] + 1
+		s.parents[neighbor] = top.vertex
+		if err := push(queueItem[K, N]{neighbor, cost}, weights[i]); err != nil {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+func (s *integerSearch[K, N]) finish() (Path[K, N], error) {
+	if s.cut {
+		return Path[K, N]{}, ErrBudgetExceeded
+	}
+	return Path[K, N]{}, ErrNoPath
+}
@@ -0,0 +1,178 @@
+package graph_test
+
+import (
+	"math"
+	"math/rand/v2"
+	"reflect"
+	"testing"
+
+	"github.com/axseem/graph"
+)
+
+func TestDial(t *testing.T) {
+	testCases := append(pathTests(), pathTest{
+		desc:     "weight out of range",
+		vertices: []string{"a", "b"},
+		edges:    []edge[string, int]{{"a", "b", 11}},
+		from:     "a",
+		to:       "b",
+		err:      graph.ErrWeightRange,
+	})
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := newWeighted(tC.vertices, tC.edges)
+
+			path, err := graph.Dial(g, tC.from, tC.to, 10)
+			if err != tC.err {
+				t.Fatalf("expected error: %v, got: %v", tC.err, err)
+			}
+			if !reflect.DeepEqual(tC.path, path.Vertices) || tC.cost != path.Cost {
+				t.Errorf("expected: %v %v, got: %v %v", tC.path, tC.cost, path.Vertices, path.Cost)
+			}
+		})
+	}
+}
+
+func TestDialMaxWeight(t *testing.T) {
+	g := newWeighted([]uint64{0, 1}, []edge[uint64, uint64]{{0, 1, 1}})
+	for _, maxWeight := range []uint64{1<<20 + 1, math.MaxInt, math.MaxUint64} {
+		if _, err := graph.Dial(g, 0, 1, maxWeight); err != graph.ErrWeightRange {
+			t.Errorf("maxWeight %v expected: %v, got: %v", maxWeight, graph.ErrWeightRange, err)
+		}
+	}
+}
+
+func TestZeroOneBFS(t *testing.T) {
+	testCases := []pathTest{
+		{
+			desc:     "nil vertex",
+			vertices: []string{"a"},
+			from:     "b",
+			to:       "a",
+			err:      graph.ErrNilVertex,
+		},
+		{
+			desc:     "no path",
+			vertices: []string{"a", "b"},
+			from:     "a",
+			to:       "b",
+			err:      graph.ErrNoPath,
+		},
+		{
+			desc:     "free corridor is longer",
+			vertices: []string{"a", "b", "c", "d", "e"},
+			edges: []edge[string, int]{
+				{"a", "e", 1}, {"a", "b", 0}, {"b", "c", 0}, {"c", "d", 0}, {"d", "e", 0},
+			},
+			from: "a",
+			to:   "e",
+			path: []string{"a", "b", "c", "d", "e"},
+			cost: 0,
+		},
+		{
+			desc:     "one door",
+			vertices: []string{"a", "b", "c"},
+			edges: []edge[string, int]{
+				{"a", "b", 1}, {"b", "c", 0}, {"a", "c", 1},
+			},
+			from: "a",
+			to:   "c",
+			path: []string{"a", "c"},
+			cost: 1,
+		},
+		{
+			desc:     "weight out of range",
+			vertices: []string{"a", "b"},
+			edges:    []edge[string, int]{{"a", "b", 2}},
+			from:     "a",
+			to:       "b",
+			err:      graph.ErrWeightRange,
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := newWeighted(tC.vertices, tC.edges)
+
+			path, err := graph.ZeroOneBFS(g, tC.from, tC.to)
+			if err != tC.err {
+				t.Fatalf("expected error: %v, got: %v", tC.err, err)
+			}
+			if !reflect.DeepEqual(tC.path, path.Vertices) || tC.cost != path.Cost {
+				t.Errorf("expected: %v %v, got: %v %v", tC.path, tC.cost, path.Vertices, path.Cost)
+			}
+		})
+	}
+}
+
+func TestIntegerPathsRandom(t *testing.T) {
+	rng := rand.New(rand.NewPCG(3, 0))
+	for i := range 100 {
+		order := 2 + rng.IntN(20)
+		vertices := make([]int, order)
+		for v := range vertices {
+			vertices[v] = v
+		}
+
+		maxWeight := 1 + rng.IntN(5)
+		edges := []edge[int, int]{}
+		binary := []edge[int, int]{}
+		for range rng.IntN(order * 3) {
+			u, v := rng.IntN(order), rng.IntN(order)
+			if u != v {
+				edges = append(edges, edge[int, int]{u, v, rng.IntN(maxWeight + 1)})
+				binary = append(binary, edge[int, int]{u, v, rng.IntN(2)})
+			}
+		}
+
+		from, to := rng.IntN(order), rng.IntN(order)
+
+		g := newWeighted(vertices, edges)
+		expect, expectErr := graph.Dijkstra(g, from, to)
+		path, err := graph.Dial(g, from, to, maxWeight)
+		if err != expectErr || path.Cost != expect.Cost {
+			t.Errorf("Dial graph %d expected: %v %v, got: %v %v", i, expect, expectErr, path, err)
+		}
+
+		g = newWeighted(vertices, binary)
+		expect, expectErr = graph.Dijkstra(g, from, to)
+		path, err = graph.ZeroOneBFS(g, from, to)
+		if err != expectErr || path.Cost != expect.Cost {
+			t.Errorf("ZeroOneBFS graph %d expected: %v %v, got: %v %v", i, expect, expectErr, path, err)
+		}
+	}
+}
+
+func BenchmarkShortestPaths(b *testing.B) {
+	const side = 100
+	vertices := make([]int, side*side)
+	edges := []edge[int, int]{}
+	rng := rand.New(rand.NewPCG(4, 0))
+	for v := range vertices {
+		vertices[v] = v
+		if v%side+1 < side {
+			edges = append(edges, edge[int, int]{v, v + 1, rng.IntN(2)}, edge[int, int]{v + 1, v, rng.IntN(2)})
+		}
+		if v+side < side*side {
+			edges = append(edges, edge[int, int]{v, v + side, rng.IntN(2)}, edge[int, int]{v + side, v, rng.IntN(2)})
+		}
+	}
+	g := newWeighted(vertices, edges)
+
+	b.Run("Dijkstra", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			graph.Dijkstra(g, 0, side*side-1)
+		}
+	})
+	b.Run("Dial", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			graph.Dial(g, 0, side*side-1, 1)
+		}
+	})
+	b.Run("ZeroOneBFS", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			graph.ZeroOneBFS(g, 0, side*side-1)
+		}
+	})
+}
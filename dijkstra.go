@@ -0,0 +1,140 @@
+package graph
+
+import "container/heap"
+
+// Path is a sequence of vertices from the first to the last one,
+// along with the total cost of its edges.
+type Path[K comparable, N Number] struct {
+	Vertices []K
+	Cost     N
+}
+
+// Dijkstra finds the cheapest path between two vertices.
+// Edge weights must not be negative.
+func Dijkstra[K comparable, N Number](g WeightedGraph[K, N], from, to K, opts ...SearchOptions) (Path[K, N], error) {
+	return dijkstra(g, from, to, newBudget(opts), nil)
+}
+
+// dijkstra ignores edges for which skip returns true, skip may be nil.
+func dijkstra[K comparable, N Number](g WeightedGraph[K, N], from, to K, b *budget, skip func(from, to K) bool) (Path[K, N], error) {
+	dist := map[K]N{from: 0}
+	hops := map[K]uint{from: 0}
+	parents := make(map[K]K)
+	settled := make(map[K]struct{})
+	queue := &priorityQueue[K, N]{{vertex: from}}
+	var cut bool
+
+	for queue.Len() > 0 {
+		top := heap.Pop(queue).(queueItem[K, N])
+		if _, ok := settled[top.vertex]; ok {
+			continue
+		}
+
+		if !b.reaches(hops[top.vertex]) {
+			cut = true
+			continue
+		}
+		if err := b.visit(); err != nil {
+			return Path[K, N]{}, err
+		}
+		settled[top.vertex] = struct{}{}
+
+		if top.vertex == to {
+			return Path[K, N]{tracePath(parents, from, to), top.cost}, nil
+		}
+
+		n := g.Adjacency(top.vertex)
+		if n == nil {
+			return Path[K, N]{}, ErrNilVertex
+		}
+		weights, err := edgeWeights(g, top.vertex, n)
+		if err != nil {
+			return Path[K, N]{}, err
+		}
+
+		for i, neighbor := range n {
+			if skip != nil && skip(top.vertex, neighbor) {
+				continue
+			}
+
+			cost := top.cost + weights[i]
+			if old, ok := dist[neighbor]; ok && old <= cost {
+				continue
+			}
+			dist[neighbor] = cost
+			hops[neighbor] = hops[top.vertex] + 1
+			parents[neighbor] = top.vertex
+			heap.Push(queue, queueItem[K, N]{neighbor, cost})
+		}
+	}
+
+	if cut {
+		return Path[K, N]{}, ErrBudgetExceeded
+	}
+	return Path[K, N]{}, ErrNoPath
+}
+
+// edgeWeights returns weights of edges from the vertex to each of its neighbors.
+func edgeWeights[K comparable, N Number](g WeightedGraph[K, N], vertex K, neighbors []K) ([]N, error) {
+	weights, err := edgeValues(g, vertex, neighbors)
+	if err != nil {
+		return nil, err
+	}
+	for _, weight := range weights {
+		if weight < 0 {
+			return nil, ErrNegativeWeight
+		}
+	}
+	return weights, nil
+}
+
+// edgeValues is edgeWeights that accepts negative values.
+func edgeValues[K comparable, N Number](g WeightedGraph[K, N], vertex K, neighbors []K) ([]N, error) {
+	edges := make([][2]K, len(neighbors))
+	for i, neighbor := range neighbors {
+		edges[i] = [2]K{vertex, neighbor}
+	}
+
+	values := g.EdgesValues(edges...)
+	if len(values) < len(edges) {
+		return nil, ErrNilVertex
+	}
+	return values, nil
+}
+
+// tracePath follows parents from the last vertex back to the first one.
+func tracePath[K comparable](parents map[K]K, from, to K) []K {
+	path := []K{to}
+	for to != from {
+		to = parents[to]
+		path = append(path, to)
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+type queueItem[K comparable, N Number] struct {
+	vertex K
+	cost   N
+}
+
+// priorityQueue implements heap.Interface, the cheapest item is on top.
+type priorityQueue[K comparable, N Number] []queueItem[K, N]
+
+func (q priorityQueue[K, N]) Len() int           { return len(q) }
+func (q priorityQueue[K, N]) Less(i, j int) bool { return q[i].cost < q[j].cost }
+func (q priorityQueue[K, N]) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+
+func (q *priorityQueue[K, N]) Push(x any) {
+	*q = append(*q, x.(queueItem[K, N]))
+}
+
+func (q *priorityQueue[K, N]) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
@@ -0,0 +1,102 @@
+package graph_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/axseem/graph"
+)
+
+type pathTest struct {
+	desc     string
+	vertices []string
+	edges    []edge[string, int]
+	from, to string
+	path     []string
+	cost     int
+	err      error
+}
+
+func pathTests() []pathTest {
+	return []pathTest{
+		{
+			desc:     "nil vertex",
+			vertices: []string{"a"},
+			from:     "b",
+			to:       "a",
+			err:      graph.ErrNilVertex,
+		},
+		{
+			desc:     "trivial path",
+			vertices: []string{"a"},
+			from:     "a",
+			to:       "a",
+			path:     []string{"a"},
+		},
+		{
+			desc:     "no path",
+			vertices: []string{"a", "b"},
+			edges:    []edge[string, int]{{"b", "a", 1}},
+			from:     "a",
+			to:       "b",
+			err:      graph.ErrNoPath,
+		},
+		{
+			desc:     "cheaper detour",
+			vertices: []string{"a", "b", "c", "d"},
+			edges: []edge[string, int]{
+				{"a", "d", 10}, {"a", "b", 1}, {"b", "c", 2}, {"c", "d", 3},
+			},
+			from: "a",
+			to:   "d",
+			path: []string{"a", "b", "c", "d"},
+			cost: 6,
+		},
+		{
+			desc:     "directed edges",
+			vertices: []string{"a", "b", "c"},
+			edges: []edge[string, int]{
+				{"a", "c", 5}, {"c", "b", 1}, {"b", "a", 1},
+			},
+			from: "a",
+			to:   "b",
+			path: []string{"a", "c", "b"},
+			cost: 6,
+		},
+		{
+			desc:     "negative weight",
+			vertices: []string{"a", "b"},
+			edges:    []edge[string, int]{{"a", "b", -1}},
+			from:     "a",
+			to:       "b",
+			err:      graph.ErrNegativeWeight,
+		},
+	}
+}
+
+func TestDijkstra(t *testing.T) {
+	for _, tC := range pathTests() {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := newWeighted(tC.vertices, tC.edges)
+
+			path, err := graph.Dijkstra(g, tC.from, tC.to)
+			if err != tC.err {
+				t.Fatalf("expected error: %v, got: %v", tC.err, err)
+			}
+			if !reflect.DeepEqual(tC.path, path.Vertices) || tC.cost != path.Cost {
+				t.Errorf("expected: %v %v, got: %v %v", tC.path, tC.cost, path.Vertices, path.Cost)
+			}
+		})
+	}
+}
+
+func TestDijkstraBudget(t *testing.T) {
+	g := newWeighted([]int{0, 1, 2, 3}, []edge[int, int]{{0, 1, 1}, {1, 2, 1}, {2, 3, 1}})
+
+	if _, err := graph.Dijkstra(g, 0, 3, graph.SearchOptions{MaxVisited: 2}); err != graph.ErrBudgetExceeded {
+		t.Errorf("expected: %v, got: %v", graph.ErrBudgetExceeded, err)
+	}
+	if _, err := graph.Dijkstra(g, 0, 3, graph.SearchOptions{MaxDepth: 2}); err != graph.ErrBudgetExceeded {
+		t.Errorf("expected: %v, got: %v", graph.ErrBudgetExceeded, err)
+	}
+}
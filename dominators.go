@@ -0,0 +1,312 @@
+package graph
+
+// DominatorTree is the result of dominance analysis of a control flow graph.
+// A vertex dominates another one if every path from the root to the other
+// one passes through it. Vertices unreachable from the root are left out.
+type DominatorTree[K comparable] struct {
+	Root K
+	// Immediate dominator of every reachable vertex except the root.
+	Parent map[K]K
+	// Vertices where dominance of every reachable vertex ends,
+	// in the same order as Order.
+	Frontier map[K][]K
+	// Reachable vertices in reverse postorder of depth first search,
+	// a vertex comes after its dominators.
+	Order []K
+
+	index map[K]int
+	preds [][]int
+	idom  []int
+	// preorder interval of every vertex in the tree
+	enter, exit []int
+}
+
+// Dominators builds the dominator tree of vertices reachable from the entry
+// using the iterative algorithm of Cooper, Harvey and Kennedy.
+func Dominators[K comparable](g Graph[K], entry K) (*DominatorTree[K], error) {
+	return dominators(g.Adjacency, entry)
+}
+
+// PostDominators builds the dominator tree of the reversed graph,
+// so a vertex post-dominates another one if every path from the other one
+// to the exit passes through it. Frontiers of the tree are the vertices
+// each vertex is control dependent on. Graphs with several exits
+// should link them to a single one first.
+func PostDominators[K comparable](g GraphReader[K], exit K) (*DominatorTree[K], error) {
+	r, err := NewReverseIndex(g)
+	if err != nil {
+		return nil, err
+	}
+	return dominators(r.Predecessors, exit)
+}
+
+func dominators[K comparable](next func(vertex K) []K, root K) (*DominatorTree[K], error) {
+	// vertices are numbered in order of discovery, the root is zero
+	type frame struct {
+		vertex    int
+		neighbors []K
+	}
+	discovered := make(map[K]int)
+	var vertices []K
+	var succ [][]int
+	var frames []frame
+	var postorder []int
+
+	discover := func(vertex K) error {
+		n := next(vertex)
+		if n == nil {
+			return ErrNilVertex
+		}
+		discovered[vertex] = len(vertices)
+		frames = append(frames, frame{len(vertices), n})
+		vertices = append(vertices, vertex)
+		succ = append(succ, nil)
+		return nil
+	}
+
+	if err := discover(root); err != nil {
+		return nil, err
+	}
+	for len(frames) > 0 {
+		top := &frames[len(frames)-1]
+
+		if len(top.neighbors) == 0 {
+			postorder = append(postorder, top.vertex)
+			frames = frames[:len(frames)-1]
+			continue
+		}
+		neighbor := top.neighbors[len(top.neighbors)-1]
+		top.neighbors = top.neighbors[:len(top.neighbors)-1]
+
+		v := top.vertex
+		if id, ok := discovered[neighbor]; ok {
+			succ[v] = append(succ[v], id)
+			continue
+		}
+		succ[v] = append(succ[v], len(vertices))
+		if err := discover(neighbor); err != nil {
+			return nil, err
+		}
+	}
+
+	n := len(vertices)
+	number := make([]int, n)
+	for i, v := range postorder {
+		number[v] = i
+	}
+	preds := make([][]int, n)
+	for u, neighbors := range succ {
+		for _, v := range neighbors {
+			preds[v] = append(preds[v], u)
+		}
+	}
+
+	// both fingers climb the tree, the one with a smaller postorder number
+	// is deeper, until they meet at the common dominator
+	idom := make([]int, n)
+	for i := range idom {
+		idom[i] = -1
+	}
+	idom[0] = 0
+	intersect := func(a, b int) int {
+		for a != b {
+			for number[a] < number[b] {
+				a = idom[a]
+			}
+			for number[b] < number[a] {
+				b = idom[b]
+			}
+		}
+		return a
+	}
+	for changed := true; changed; {
+		changed = false
+		for i := n - 2; i >= 0; i-- {
+			v := postorder[i]
+			dominator := -1
+			for _, p := range preds[v] {
+				switch {
+				case idom[p] == -1:
+				case dominator == -1:
+					dominator = p
+				default:
+					dominator = intersect(p, dominator)
+				}
+			}
+			if idom[v] != dominator {
+				idom[v] = dominator
+				changed = true
+			}
+		}
+	}
+
+	t := &DominatorTree[K]{
+		Root:     root,
+		Parent:   make(map[K]K, n),
+		Frontier: make(map[K][]K, n),
+		Order:    make([]K, n),
+		index:    make(map[K]int, n),
+		preds:    make([][]int, n),
+		idom:     make([]int, n),
+		enter:    make([]int, n),
+		exit:     make([]int, n),
+	}
+
+	// the tree keeps vertices numbered in reverse postorder
+	rpo := make([]int, n)
+	for i, v := range postorder {
+		rpo[v] = n - 1 - i
+	}
+	for v, vertex := range vertices {
+		t.Order[rpo[v]] = vertex
+		t.index[vertex] = rpo[v]
+		for _, p := range preds[v] {
+			t.preds[rpo[v]] = append(t.preds[rpo[v]], rpo[p])
+		}
+		t.idom[rpo[v]] = rpo[idom[v]]
+		if v != 0 {
+			t.Parent[vertex] = vertices[idom[v]]
+		}
+	}
+
+	// frontier of a vertex holds joins it reaches without dominating them.
+	// The root is also entered from outside, so it is a join as soon as
+	// it has a predecessor, and runners climb up to the root itself.
+	frontiers := make([][]int, n)
+	for b := range n {
+		joins, stop := len(t.preds[b]), t.idom[b]
+		if b == 0 {
+			joins, stop = joins+1, -1
+		}
+		if joins < 2 {
+			continue
+		}
+		for _, runner := range t.preds[b] {
+			for runner != stop {
+				if len(frontiers[runner]) == 0 || frontiers[runner][len(frontiers[runner])-1] != b {
+					frontiers[runner] = append(frontiers[runner], b)
+				}
+				if runner == 0 {
+					break
+				}
+				runner = t.idom[runner]
+			}
+		}
+	}
+	for v, frontier := range frontiers {
+		vertices := make([]K, len(frontier))
+		for i, w := range frontier {
+			vertices[i] = t.Order[w]
+		}
+		t.Frontier[t.Order[v]] = vertices
+	}
+
+	// dominator tree is numbered so that descendants fall into the interval
+	children := make([][]int, n)
+	for v := 1; v < n; v++ {
+		children[t.idom[v]] = append(children[t.idom[v]], v)
+	}
+	type node struct {
+		vertex, next int
+	}
+	counter := 0
+	t.enter[0] = counter
+	stack := []node{{vertex: 0}}
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		if top.next < len(children[top.vertex]) {
+			child := children[top.vertex][top.next]
+			top.next++
+			counter++
+			t.enter[child] = counter
+			stack = append(stack, node{vertex: child})
+			continue
+		}
+		t.exit[top.vertex] = counter
+		stack = stack[:len(stack)-1]
+	}
+
+	return t, nil
+}
+
+// Dominates reports whether every path from the root to b passes through a,
+// a vertex dominates itself. Returns false for unreachable vertices.
+func (t *DominatorTree[K]) Dominates(a, b K) bool {
+	i, ok := t.index[a]
+	if !ok {
+		return false
+	}
+	j, ok := t.index[b]
+	if !ok {
+		return false
+	}
+	return t.enter[i] <= t.enter[j] && t.exit[j] <= t.exit[i]
+}
+
+// Loop is a natural loop of a control flow graph.
+type Loop[K comparable] struct {
+	// The only vertex through which the loop is entered,
+	// it dominates every vertex of the loop.
+	Header K
+	// Vertices of the loop in the same order as Order of the tree,
+	// including vertices of nested loops. Header is the first one.
+	Vertices []K
+	// The innermost loop that contains this one, nil for outermost loops.
+	Parent *Loop[K]
+	// Amount of loops that contain this one, including itself.
+	Depth int
+}
+
+// Loops finds natural loops from back edges, edges that lead to their
+// dominator. Loops sharing a header are merged into one. Cycles that are
+// entered through several vertices have no back edges and are left out.
+// Loops are in the same order as their headers in Order, so a loop comes
+// after the ones that contain it.
+func (t *DominatorTree[K]) Loops() []*Loop[K] {
+	n := len(t.Order)
+	var loops []*Loop[K]
+	var bodies [][]bool
+
+	for h := range n {
+		var stack []int
+		for _, p := range t.preds[h] {
+			if t.Dominates(t.Order[h], t.Order[p]) {
+				stack = append(stack, p)
+			}
+		}
+		if len(stack) == 0 {
+			continue
+		}
+
+		// the body is everything that reaches a back edge avoiding the header
+		body := make([]bool, n)
+		body[h] = true
+		for len(stack) > 0 {
+			v := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if body[v] {
+				continue
+			}
+			body[v] = true
+			stack = append(stack, t.preds[v]...)
+		}
+
+		loop := &Loop[K]{Header: t.Order[h], Depth: 1}
+		for v, ok := range body {
+			if ok {
+				loop.Vertices = append(loop.Vertices, t.Order[v])
+			}
+		}
+		for i := len(loops) - 1; i >= 0; i-- {
+			if bodies[i][h] {
+				loop.Parent = loops[i]
+				loop.Depth = loops[i].Depth + 1
+				break
+			}
+		}
+		loops = append(loops, loop)
+		bodies = append(bodies, body)
+	}
+
+	return loops
+}
@@ -0,0 +1,180 @@
+package graph_test
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/axseem/graph"
+)
+
+// controlFlow is a function with a loop in its body nested in another loop,
+// followed by one more loop before the exit.
+func controlFlow() *graph.Mapped[int] {
+	g := graph.NewMapped[int]()
+	if err := g.AddVertices(0, 1, 2, 3, 4, 5, 6, 7, 8, 9); err != nil {
+		panic(err)
+	}
+	if err := g.AddEdges(
+		[2]int{0, 1}, [2]int{1, 2}, [2]int{1, 3}, [2]int{2, 8}, [2]int{8, 2},
+		[2]int{2, 4}, [2]int{3, 4}, [2]int{4, 1}, [2]int{4, 5}, [2]int{5, 6},
+		[2]int{6, 5}, [2]int{5, 7},
+	); err != nil {
+		panic(err)
+	}
+	return g
+}
+
+func TestDominators(t *testing.T) {
+	g := controlFlow()
+
+	tree, err := graph.Dominators(g, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parent := map[int]int{1: 0, 2: 1, 3: 1, 4: 1, 5: 4, 6: 5, 7: 5, 8: 2}
+	if !reflect.DeepEqual(parent, tree.Parent) {
+		t.Errorf("expected parents: %v, got: %v", parent, tree.Parent)
+	}
+
+	frontier := map[int][]int{0: {}, 1: {1}, 2: {2, 4}, 3: {4}, 4: {1}, 5: {5}, 6: {5}, 7: {}, 8: {2}}
+	for _, vertices := range tree.Frontier {
+		slices.Sort(vertices)
+	}
+	if !reflect.DeepEqual(frontier, tree.Frontier) {
+		t.Errorf("expected frontiers: %v, got: %v", frontier, tree.Frontier)
+	}
+
+	// vertex 9 is unreachable
+	if len(tree.Order) != 9 || tree.Order[0] != 0 {
+		t.Errorf("expected 9 reachable vertices starting from the entry, got: %v", tree.Order)
+	}
+	for vertex, dominator := range tree.Parent {
+		if slices.Index(tree.Order, dominator) > slices.Index(tree.Order, vertex) {
+			t.Errorf("expected %v to come before %v in %v", dominator, vertex, tree.Order)
+		}
+	}
+
+	dominates := []struct {
+		a, b   int
+		output bool
+	}{
+		{0, 7, true}, {1, 8, true}, {4, 6, true}, {2, 2, true},
+		{2, 4, false}, {3, 4, false}, {8, 2, false}, {6, 7, false}, {0, 9, false},
+	}
+	for _, d := range dominates {
+		if output := tree.Dominates(d.a, d.b); output != d.output {
+			t.Errorf("expected %v dominates %v: %v, got: %v", d.a, d.b, d.output, output)
+		}
+	}
+}
+
+func TestDominatorsNilVertex(t *testing.T) {
+	if _, err := graph.Dominators(controlFlow(), 10); err != graph.ErrNilVertex {
+		t.Errorf("expected: %v, got: %v", graph.ErrNilVertex, err)
+	}
+}
+
+func TestPostDominators(t *testing.T) {
+	tree, err := graph.PostDominators(controlFlow(), 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parent := map[int]int{0: 1, 1: 4, 2: 4, 3: 4, 4: 5, 5: 7, 6: 5, 8: 2}
+	if !reflect.DeepEqual(parent, tree.Parent) {
+		t.Errorf("expected parents: %v, got: %v", parent, tree.Parent)
+	}
+
+	// branches every vertex is control dependent on
+	frontier := map[int][]int{0: {}, 1: {4}, 2: {1, 2}, 3: {1}, 4: {4}, 5: {5}, 6: {5}, 7: {}, 8: {2}}
+	for _, vertices := range tree.Frontier {
+		slices.Sort(vertices)
+	}
+	if !reflect.DeepEqual(frontier, tree.Frontier) {
+		t.Errorf("expected frontiers: %v, got: %v", frontier, tree.Frontier)
+	}
+}
+
+func TestLoops(t *testing.T) {
+	tree, err := graph.Dominators(controlFlow(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loops := tree.Loops()
+	if len(loops) != 3 {
+		t.Fatalf("expected 3 loops, got: %v", len(loops))
+	}
+
+	expect := []struct {
+		header   int
+		vertices []int
+		parent   int
+		depth    int
+	}{
+		{1, []int{1, 2, 3, 4, 8}, -1, 1},
+		{2, []int{2, 8}, 1, 2},
+		{5, []int{5, 6}, -1, 1},
+	}
+	for i, e := range expect {
+		loop := loops[i]
+		if loop.Vertices[0] != loop.Header {
+			t.Errorf("expected header %v to come first in %v", loop.Header, loop.Vertices)
+		}
+		vertices := slices.Sorted(slices.Values(loop.Vertices))
+		parent := -1
+		if loop.Parent != nil {
+			parent = loop.Parent.Header
+		}
+		if loop.Header != e.header || !reflect.DeepEqual(e.vertices, vertices) || parent != e.parent || loop.Depth != e.depth {
+			t.Errorf("expected loop: %v %v %v %v, got: %v %v %v %v",
+				e.header, e.vertices, e.parent, e.depth, loop.Header, vertices, parent, loop.Depth)
+		}
+	}
+}
+
+func TestDominatorsEntryLoop(t *testing.T) {
+	// the entry block is a loop header, so it is a join of the loop
+	// and the implicit edge entering the function
+	g := graph.NewMapped[int]()
+	if err := g.AddVertices(0, 1, 2); err != nil {
+		panic(err)
+	}
+	if err := g.AddEdges([2]int{0, 1}, [2]int{1, 0}, [2]int{0, 2}); err != nil {
+		panic(err)
+	}
+
+	tree, err := graph.Dominators(g, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	frontier := map[int][]int{0: {0}, 1: {0}, 2: {}}
+	if !reflect.DeepEqual(frontier, tree.Frontier) {
+		t.Errorf("expected frontiers: %v, got: %v", frontier, tree.Frontier)
+	}
+
+	loops := tree.Loops()
+	if len(loops) != 1 || loops[0].Header != 0 {
+		t.Errorf("expected a single loop with header 0, got: %v", loops)
+	}
+
+	// the exit has an outgoing edge, so it is a join of the reversed graph
+	g = graph.NewMapped[int]()
+	if err := g.AddVertices(0, 1, 2); err != nil {
+		panic(err)
+	}
+	if err := g.AddEdges([2]int{0, 1}, [2]int{1, 2}, [2]int{2, 1}); err != nil {
+		panic(err)
+	}
+
+	tree, err = graph.PostDominators(g, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	frontier = map[int][]int{0: {}, 1: {2}, 2: {2}}
+	if !reflect.DeepEqual(frontier, tree.Frontier) {
+		t.Errorf("expected post-dominance frontiers: %v, got: %v", frontier, tree.Frontier)
+	}
+}
@@ -0,0 +1,98 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// Execute runs task for every vertex of a dependency graph, where an edge
+// from one vertex to another means the former depends on the latter.
+// A task starts only after tasks of all its dependencies succeed, at most
+// workers tasks run at once, a non-positive amount means GOMAXPROCS.
+// The first error cancels the context passed to tasks, tasks that have not
+// started yet are skipped, and the error is returned once running ones finish.
+// Cycles are detected before any task starts and reported as ErrCycle.
+func Execute[K comparable](ctx context.Context, g GraphReader[K], workers int, task func(ctx context.Context, vertex K) error) error {
+	c, err := newCompact(g)
+	if err != nil {
+		return err
+	}
+	if cycle := anyCycle(c.adj); cycle != nil {
+		return fmt.Errorf("%w: %v", ErrCycle, c.cycle(cycle))
+	}
+
+	n := c.order()
+	if n == 0 {
+		return nil
+	}
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = min(workers, n)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		vertex int
+		err    error
+	}
+	// both channels fit every vertex, so sending never blocks
+	ready := make(chan int, n)
+	results := make(chan result, n)
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for v := range ready {
+				err := ctx.Err()
+				if err == nil {
+					err = task(ctx, c.vertices[v])
+				}
+				results <- result{v, err}
+			}
+		}()
+	}
+
+	dependencies := make([]int, n)
+	var scheduled int
+	for v, neighbors := range c.adj {
+		dependencies[v] = len(neighbors)
+		if dependencies[v] == 0 {
+			ready <- v
+			scheduled++
+		}
+	}
+
+	dependents := c.transpose()
+	var first error
+	for ; scheduled > 0; scheduled-- {
+		r := <-results
+		if r.err != nil {
+			if first == nil {
+				first = r.err
+				cancel()
+			}
+			continue
+		}
+		if first != nil {
+			continue
+		}
+
+		for _, d := range dependents[r.vertex] {
+			dependencies[d]--
+			if dependencies[d] == 0 {
+				ready <- d
+				scheduled++
+			}
+		}
+	}
+
+	close(ready)
+	wg.Wait()
+	return first
+}
@@ -0,0 +1,127 @@
+package graph_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/axseem/graph"
+)
+
+// build is a graph of build targets, edges lead to dependencies.
+func build() *graph.Mapped[string] {
+	g := graph.NewMapped[string]()
+	if err := g.AddVertices("app", "cli", "http", "json", "log", "test"); err != nil {
+		panic(err)
+	}
+	if err := g.AddEdges(
+		[2]string{"app", "http"}, [2]string{"app", "json"}, [2]string{"cli", "log"},
+		[2]string{"http", "log"}, [2]string{"json", "log"}, [2]string{"test", "app"},
+		[2]string{"test", "cli"},
+	); err != nil {
+		panic(err)
+	}
+	return g
+}
+
+func TestExecute(t *testing.T) {
+	g := build()
+
+	var mu sync.Mutex
+	var finished []string
+	var running, peak int
+	err := graph.Execute(context.Background(), g, 2, func(ctx context.Context, vertex string) error {
+		mu.Lock()
+		running++
+		peak = max(peak, running)
+		for _, dependency := range g.Adjacency(vertex) {
+			if !slices.Contains(finished, dependency) {
+				t.Errorf("%v started before its dependency %v finished", vertex, dependency)
+			}
+		}
+		mu.Unlock()
+
+		time.Sleep(time.Millisecond)
+		mu.Lock()
+		running--
+		finished = append(finished, vertex)
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(finished) != g.Order() {
+		t.Errorf("expected %v tasks, got: %v", g.Order(), finished)
+	}
+	if peak > 2 {
+		t.Errorf("expected at most 2 tasks at once, got: %v", peak)
+	}
+}
+
+func TestExecuteError(t *testing.T) {
+	g := build()
+	fail := errors.New("compile error")
+
+	var mu sync.Mutex
+	var started []string
+	err := graph.Execute(context.Background(), g, 1, func(ctx context.Context, vertex string) error {
+		mu.Lock()
+		started = append(started, vertex)
+		mu.Unlock()
+		if vertex == "http" {
+			return fail
+		}
+		return nil
+	})
+	if err != fail {
+		t.Fatalf("expected error: %v, got: %v", fail, err)
+	}
+	for _, vertex := range []string{"app", "test"} {
+		if slices.Contains(started, vertex) {
+			t.Errorf("expected %v to be skipped, got: %v", vertex, started)
+		}
+	}
+}
+
+func TestExecuteCancel(t *testing.T) {
+	g := build()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var count atomic.Int32
+	err := graph.Execute(ctx, g, 1, func(ctx context.Context, vertex string) error {
+		count.Add(1)
+		cancel()
+		return nil
+	})
+	if err != context.Canceled {
+		t.Fatalf("expected error: %v, got: %v", context.Canceled, err)
+	}
+	if count.Load() != 1 {
+		t.Errorf("expected a single task, got: %v", count.Load())
+	}
+}
+
+func TestExecuteCycle(t *testing.T) {
+	g := build()
+	if err := g.AddEdges([2]string{"log", "test"}); err != nil {
+		panic(err)
+	}
+
+	var count atomic.Int32
+	err := graph.Execute(context.Background(), g, 0, func(ctx context.Context, vertex string) error {
+		count.Add(1)
+		return nil
+	})
+	if !errors.Is(err, graph.ErrCycle) {
+		t.Fatalf("expected error: %v, got: %v", graph.ErrCycle, err)
+	}
+	if count.Load() != 0 {
+		t.Errorf("expected no tasks, got: %v", count.Load())
+	}
+}
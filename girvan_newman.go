@@ -0,0 +1,191 @@
+package graph
+
+import (
+	"math"
+	"slices"
+)
+
+// Cluster is a node of Dendrogram.
+type Cluster[K comparable] struct {
+	Vertices []K
+	// Level at which the cluster splits into Children.
+	// Zero if the cluster never splits.
+	Level    int
+	Children []*Cluster[K]
+}
+
+// Dendrogram describes how the graph falls apart as edges get removed.
+type Dendrogram[K comparable] struct {
+	// Connected components of the original graph.
+	Roots []*Cluster[K]
+	// Amount of splits, Cut at this level returns single vertex clusters.
+	Levels int
+}
+
+// Cut returns the clusters present after the given amount of splits.
+// Level zero returns the connected components of the original graph.
+func (d *Dendrogram[K]) Cut(level int) [][]K {
+	clusters := [][]K{}
+	var cut func(c *Cluster[K])
+	cut = func(c *Cluster[K]) {
+		if c.Level == 0 || c.Level > level {
+			clusters = append(clusters, c.Vertices)
+			return
+		}
+		for _, child := range c.Children {
+			cut(child)
+		}
+	}
+
+	for _, root := range d.Roots {
+		cut(root)
+	}
+	return clusters
+}
+
+// GirvanNewman builds a divisive hierarchical clustering by repeatedly
+// removing the edge with the highest betweenness from a working copy of g.
+// The graph is treated as undirected and is not modified.
+func GirvanNewman[K comparable](g GraphReader[K]) (*Dendrogram[K], error) {
+	c, err := newCompact(g)
+	if err != nil {
+		return nil, err
+	}
+
+	n := c.order()
+	work := NewMapped[int]()
+	edges := 0
+	for i := range n {
+		if err := work.AddVertices(i); err != nil {
+			return nil, err
+		}
+	}
+	for u, neighbors := range c.undirected() {
+		for _, v := range neighbors {
+			if err := work.AddEdges([2]int{u, v}); err != nil {
+				return nil, err
+			}
+			edges++
+		}
+	}
+	edges /= 2
+
+	d := &Dendrogram[K]{Roots: []*Cluster[K]{}}
+	// leaf cluster that currently holds the vertex
+	leaves := make([]*Cluster[K], n)
+	reached := make([]bool, n)
+	for u := range n {
+		if leaves[u] != nil {
+			continue
+		}
+
+		root := &Cluster[K]{}
+		component := []int{}
+		err := BFS[int](work, u, func(v int, _ uint) bool {
+			leaves[v] = root
+			component = append(component, v)
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		slices.Sort(component)
+		for _, v := range component {
+			root.Vertices = append(root.Vertices, c.vertices[v])
+		}
+		d.Roots = append(d.Roots, root)
+	}
+
+	for ; edges > 0; edges-- {
+		edge := maxBetweennessEdge(work, n)
+		work.DeleteEdges(edge, [2]int{edge[1], edge[0]})
+
+		clear(reached)
+		err := BFS[int](work, edge[0], func(v int, _ uint) bool {
+			reached[v] = true
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+		if reached[edge[1]] {
+			continue
+		}
+
+		d.Levels++
+		parent := leaves[edge[0]]
+		parent.Level = d.Levels
+		first, second := &Cluster[K]{}, &Cluster[K]{}
+		for _, vertex := range parent.Vertices {
+			i := c.index[vertex]
+			child := second
+			if reached[i] == reached[c.index[parent.Vertices[0]]] {
+				child = first
+			}
+			child.Vertices = append(child.Vertices, vertex)
+			leaves[i] = child
+		}
+		parent.Children = []*Cluster[K]{first, second}
+	}
+
+	return d, nil
+}
+
+// maxBetweennessEdge computes edge betweenness with Brandes algorithm
+// and returns the edge with the highest value.
+// Ties are resolved in favour of the edge met first.
+func maxBetweennessEdge(g *Mapped[int], n int) [2]int {
+	betweenness := make(map[[2]int]float64)
+	sigma := make([]float64, n)
+	delta := make([]float64, n)
+	dist := make([]int, n)
+	predecessors := make([][]int, n)
+
+	for s := range n {
+		for i := range n {
+			sigma[i], delta[i], dist[i] = 0, 0, -1
+			predecessors[i] = predecessors[i][:0]
+		}
+		sigma[s], dist[s] = 1, 0
+
+		order := []int{}
+		queue := []int{s}
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+			order = append(order, v)
+
+			for _, w := range g.Adjacency(v) {
+				if dist[w] < 0 {
+					dist[w] = dist[v] + 1
+					queue = append(queue, w)
+				}
+				if dist[w] == dist[v]+1 {
+					sigma[w] += sigma[v]
+					predecessors[w] = append(predecessors[w], v)
+				}
+			}
+		}
+
+		for i := len(order) - 1; i >= 0; i-- {
+			w := order[i]
+			for _, v := range predecessors[w] {
+				share := sigma[v] / sigma[w] * (1 + delta[w])
+				betweenness[[2]int{min(v, w), max(v, w)}] += share
+				delta[v] += share
+			}
+		}
+	}
+
+	var best [2]int
+	highest := math.Inf(-1)
+	for u := range n {
+		for _, v := range g.Adjacency(u) {
+			if u < v && betweenness[[2]int{u, v}] > highest+1e-9 {
+				best, highest = [2]int{u, v}, betweenness[[2]int{u, v}]
+			}
+		}
+	}
+	return best
+}
@@ -0,0 +1,98 @@
+package graph_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/axseem/graph"
+)
+
+func TestGirvanNewman(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		order  uint
+		edges  [][2]uint
+		levels int
+		cuts   map[int][][]uint
+	}{
+		{
+			desc:   "empty graph",
+			order:  0,
+			levels: 0,
+			cuts:   map[int][][]uint{0: {}},
+		},
+		{
+			desc:   "null graph",
+			order:  2,
+			levels: 0,
+			cuts:   map[int][][]uint{0: {{0}, {1}}},
+		},
+		{
+			desc:   "graph:0→1",
+			order:  2,
+			edges:  [][2]uint{{0, 1}},
+			levels: 1,
+			cuts: map[int][][]uint{
+				0: {{0, 1}},
+				1: {{0}, {1}},
+			},
+		},
+		{
+			desc:   "two triangles joined by edge",
+			order:  6,
+			edges:  undirected([][2]uint{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {4, 5}, {5, 3}}),
+			levels: 5,
+			cuts: map[int][][]uint{
+				0:  {{0, 1, 2, 3, 4, 5}},
+				1:  {{0, 1, 2}, {3, 4, 5}},
+				5:  {{0}, {1}, {2}, {3}, {4}, {5}},
+				10: {{0}, {1}, {2}, {3}, {4}, {5}},
+			},
+		},
+		{
+			desc:   "disconnected paths",
+			order:  5,
+			edges:  [][2]uint{{0, 1}, {1, 2}, {3, 4}},
+			levels: 3,
+			cuts: map[int][][]uint{
+				0: {{0, 1, 2}, {3, 4}},
+			},
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := newIndexed(tC.order, tC.edges)
+
+			d, err := graph.GirvanNewman(g)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if d.Levels != tC.levels {
+				t.Errorf("expected levels: %v, got: %v", tC.levels, d.Levels)
+			}
+			for level, expect := range tC.cuts {
+				if clusters := d.Cut(level); !reflect.DeepEqual(expect, clusters) {
+					t.Errorf("level %d expected: %v, got: %v", level, expect, clusters)
+				}
+			}
+		})
+	}
+}
+
+func TestGirvanNewmanKeepsGraph(t *testing.T) {
+	edges := undirected([][2]uint{{0, 1}, {1, 2}, {2, 3}})
+	g := newIndexed(4, edges)
+
+	if _, err := graph.GirvanNewman(g); err != nil {
+		t.Fatal(err)
+	}
+
+	expect := newIndexed(4, edges)
+	for v := range uint(4) {
+		if !reflect.DeepEqual(expect.Adjacency(v), g.Adjacency(v)) {
+			t.Errorf("vertex %d expected: %v, got: %v", v, expect.Adjacency(v), g.Adjacency(v))
+		}
+	}
+}
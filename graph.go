@@ -10,9 +10,26 @@ type Number interface {
 		~float32 | ~float64
 }
 
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
 var ErrNilVertex = errors.New("nil vertex")
 var ErrVertexExists = errors.New("vertex already exists")
 var ErrLoop = errors.New("simple graph can't contain loops")
+var ErrInfiniteGraph = errors.New("infinite graph")
+var ErrNoConvergence = errors.New("iteration did not converge")
+var ErrPartition = errors.New("partition does not cover every vertex")
+var ErrDisconnected = errors.New("graph is disconnected")
+var ErrBudgetExceeded = errors.New("search budget exceeded")
+var ErrNoPath = errors.New("no path between vertices")
+var ErrNegativeWeight = errors.New("negative edge weight")
+var ErrWeightRange = errors.New("edge weight out of range")
+var ErrDimension = errors.New("vector length mismatch")
+var ErrAcyclic = errors.New("graph has no cycles")
+var ErrUnsatisfiable = errors.New("formula is unsatisfiable")
+var ErrCycle = errors.New("graph contains a cycle")
 
 // Graph is the interface that wraps the basic Adjacency method.
 type Graph[K comparable] interface {
@@ -29,6 +46,13 @@ type WeightedGraph[K comparable, N Number] interface {
 	EdgesValues(vertices ...[2]K) []N
 }
 
+// MultiWeightedGraph has a vector of values on every edge,
+// such as cost, time and fuel it takes to pass the edge.
+type MultiWeightedGraph[K comparable, N Number] interface {
+	Graph[K]
+	EdgesVectors(edges ...[2]K) [][]N
+}
+
 // Reader is the interface that defines graph data read methods.
 type Reader[K comparable] interface {
 	// Returns all vertices in the graph.
@@ -41,6 +65,21 @@ type Reader[K comparable] interface {
 	Order() int
 }
 
+type GraphReader[K comparable] interface {
+	Graph[K]
+	Reader[K]
+}
+
+type WeightedGraphReader[K comparable, N Number] interface {
+	WeightedGraph[K, N]
+	Reader[K]
+}
+
+type MultiWeightedGraphReader[K comparable, N Number] interface {
+	MultiWeightedGraph[K, N]
+	Reader[K]
+}
+
 // Reader is the interface that defines methods that allow modify graph data.
 type Writer[K comparable] interface {
 	AddVertices(vertices ...K) error
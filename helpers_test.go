@@ -0,0 +1,95 @@
+package graph_test
+
+import "github.com/axseem/graph"
+
+func newIndexed(order uint, edges [][2]uint) *graph.Indexed[uint] {
+	g := graph.NewIndexed[uint]()
+	if err := g.AddVertices(order); err != nil {
+		panic(err)
+	}
+	if err := g.AddEdges(edges...); err != nil {
+		panic(err)
+	}
+	return g
+}
+
+func undirected(edges [][2]uint) [][2]uint {
+	result := make([][2]uint, 0, len(edges)*2)
+	for _, edge := range edges {
+		result = append(result, edge, [2]uint{edge[1], edge[0]})
+	}
+	return result
+}
+
+type edge[K comparable, N graph.Number] struct {
+	from, to K
+	weight   N
+}
+
+// weighted is a Mapped graph with values attached to vertices and edges.
+type weighted[K comparable, N graph.Number] struct {
+	*graph.Mapped[K]
+	vertices map[K]N
+	edges    map[[2]K]N
+}
+
+func newWeighted[K comparable, N graph.Number](vertices []K, edges []edge[K, N]) *weighted[K, N] {
+	g := &weighted[K, N]{
+		Mapped:   graph.NewMapped[K](),
+		vertices: make(map[K]N),
+		edges:    make(map[[2]K]N),
+	}
+	if err := g.AddVertices(vertices...); err != nil {
+		panic(err)
+	}
+	for _, e := range edges {
+		if err := g.AddEdges([2]K{e.from, e.to}); err != nil {
+			panic(err)
+		}
+		g.edges[[2]K{e.from, e.to}] = e.weight
+	}
+	return g
+}
+
+func (g *weighted[K, N]) VerticesValues(vertices ...K) []N {
+	values := make([]N, len(vertices))
+	for i, vertex := range vertices {
+		values[i] = g.vertices[vertex]
+	}
+	return values
+}
+
+func (g *weighted[K, N]) EdgesValues(edges ...[2]K) []N {
+	values := make([]N, len(edges))
+	for i, edge := range edges {
+		values[i] = g.edges[edge]
+	}
+	return values
+}
+
+// vectored is a Mapped graph with a vector of values on every edge.
+type vectored struct {
+	*graph.Mapped[string]
+	edges map[[2]string][]int
+}
+
+func newVectored(vertices []string, edges map[[2]string][]int) *vectored {
+	g := &vectored{graph.NewMapped[string](), edges}
+	if err := g.AddVertices(vertices...); err != nil {
+		panic(err)
+	}
+	for e := range edges {
+		if err := g.AddEdges(e); err != nil {
+			panic(err)
+		}
+	}
+	return g
+}
+
+func (g *vectored) EdgesVectors(edges ...[2]string) [][]int {
+	vectors := make([][]int, len(edges))
+	for i, edge := range edges {
+		vectors[i] = g.edges[edge]
+	}
+	return vectors
+}
@@ -28,7 +28,7 @@ func (g *Indexed[U]) Adjacency(vertex U) []U {
 }
 
 func (g *Indexed[U]) Vertices() []U {
-	vertices := make([]U, len(g.vertices))
+	vertices := make([]U, 0, len(g.vertices))
 	for i := range g.vertices {
 		vertices = append(vertices, U(i))
 	}
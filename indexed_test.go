@@ -42,3 +42,13 @@ func TestIndexedAdjacency(t *testing.T) {
 		})
 	}
 }
+
+func TestIndexedVertices(t *testing.T) {
+	g := graph.NewIndexed[uint]()
+	g.AddVertices(3)
+
+	expect := []uint{0, 1, 2}
+	if vertices := g.Vertices(); !reflect.DeepEqual(expect, vertices) {
+		t.Errorf("expected: %v, got: %v", expect, vertices)
+	}
+}
@@ -0,0 +1,177 @@
+package graph
+
+import "iter"
+
+// BFSIterator yields vertices in the same order as BFS, one at a time.
+// Neighbors of a vertex are requested only when the vertex is yielded,
+// so it is safe to use on infinite graphs.
+type BFSIterator[K comparable] struct {
+	g       Graph[K]
+	budget  *budget
+	queue   []depthItem[K]
+	visited map[K]struct{}
+	err     error
+}
+
+type depthItem[K comparable] struct {
+	vertex K
+	depth  uint
+}
+
+func NewBFSIterator[K comparable](g Graph[K], entry K, opts ...SearchOptions) *BFSIterator[K] {
+	return &BFSIterator[K]{
+		g:       g,
+		budget:  newBudget(opts),
+		queue:   []depthItem[K]{{vertex: entry}},
+		visited: map[K]struct{}{entry: {}},
+	}
+}
+
+// Next returns the next vertex and its depth.
+// Returns false if there are no more vertices or an error occurred.
+func (it *BFSIterator[K]) Next() (vertex K, depth uint, ok bool) {
+	if it.err != nil || len(it.queue) == 0 {
+		return vertex, 0, false
+	}
+
+	bottom := it.queue[0]
+	if !it.budget.reaches(bottom.depth) {
+		it.err = ErrBudgetExceeded
+		return vertex, 0, false
+	}
+	if it.err = it.budget.visit(); it.err != nil {
+		return vertex, 0, false
+	}
+
+	n := it.g.Adjacency(bottom.vertex)
+	if n == nil {
+		it.err = ErrNilVertex
+		return vertex, 0, false
+	}
+	it.queue = it.queue[1:]
+
+	for _, neighbor := range n {
+		if _, ok := it.visited[neighbor]; ok {
+			continue
+		}
+		it.visited[neighbor] = struct{}{}
+		it.queue = append(it.queue, depthItem[K]{neighbor, bottom.depth + 1})
+	}
+
+	return bottom.vertex, bottom.depth, true
+}
+
+// Err returns the error that stopped the iteration.
+func (it *BFSIterator[K]) Err() error {
+	return it.err
+}
+
+// All returns the remaining vertices with their depth as a sequence.
+// Check Err once the sequence is over.
+func (it *BFSIterator[K]) All() iter.Seq2[K, uint] {
+	return func(yield func(K, uint) bool) {
+		for {
+			vertex, depth, ok := it.Next()
+			if !ok || !yield(vertex, depth) {
+				return
+			}
+		}
+	}
+}
+
+// DFSIterator yields vertices in the same order as DFS, one at a time.
+// Neighbors of a vertex are requested only when the vertex is yielded,
+// so it is safe to use on infinite graphs.
+type DFSIterator[K comparable] struct {
+	g       Graph[K]
+	budget  *budget
+	stack   []depthItem[K]
+	visited map[K]struct{}
+	cut     []K
+	err     error
+}
+
+func NewDFSIterator[K comparable](g Graph[K], entry K, opts ...SearchOptions) *DFSIterator[K] {
+	return &DFSIterator[K]{
+		g:       g,
+		budget:  newBudget(opts),
+		stack:   []depthItem[K]{{vertex: entry}},
+		visited: make(map[K]struct{}),
+	}
+}
+
+// Next returns the next vertex.
+// Returns false if there are no more vertices or an error occurred.
+func (it *DFSIterator[K]) Next() (vertex K, ok bool) {
+	for it.err == nil && len(it.stack) > 0 {
+		top := it.stack[len(it.stack)-1]
+		if _, ok := it.visited[top.vertex]; ok {
+			it.stack = it.stack[:len(it.stack)-1]
+			continue
+		}
+		if !it.budget.reaches(top.depth) {
+			it.stack = it.stack[:len(it.stack)-1]
+			it.cut = append(it.cut, top.vertex)
+			continue
+		}
+		if it.err = it.budget.visit(); it.err != nil {
+			break
+		}
+
+		n := it.g.Adjacency(top.vertex)
+		if n == nil {
+			it.err = ErrNilVertex
+			break
+		}
+
+		it.stack = it.stack[:len(it.stack)-1]
+		for _, neighbor := range n {
+			it.stack = append(it.stack, depthItem[K]{neighbor, top.depth + 1})
+		}
+		it.visited[top.vertex] = struct{}{}
+		return top.vertex, true
+	}
+
+	for _, cut := range it.cut {
+		if _, ok := it.visited[cut]; !ok && it.err == nil {
+			it.err = ErrBudgetExceeded
+		}
+	}
+	it.cut = nil
+	return vertex, false
+}
+
+// Err returns the error that stopped the iteration.
+func (it *DFSIterator[K]) Err() error {
+	return it.err
+}
+
+// All returns the remaining vertices as a sequence.
+// Check Err once the sequence is over.
+func (it *DFSIterator[K]) All() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for {
+			vertex, ok := it.Next()
+			if !ok || !yield(vertex) {
+				return
+			}
+		}
+	}
+}
+
+// BFSSeq returns vertices reachable from entry with their depth,
+// in the same order as BFS. The sequence ends early on error,
+// use BFSIterator to inspect it.
+func BFSSeq[K comparable](g Graph[K], entry K, opts ...SearchOptions) iter.Seq2[K, uint] {
+	return func(yield func(K, uint) bool) {
+		NewBFSIterator(g, entry, opts...).All()(yield)
+	}
+}
+
+// DFSSeq returns vertices reachable from entry in the same order as DFS.
+// The sequence ends early on error, use DFSIterator to inspect it.
+func DFSSeq[K comparable](g Graph[K], entry K, opts ...SearchOptions) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		NewDFSIterator(g, entry, opts...).All()(yield)
+	}
+}
@@ -0,0 +1,126 @@
+package graph_test
+
+import (
+	"iter"
+	"reflect"
+	"testing"
+
+	"github.com/axseem/graph"
+)
+
+func TestBFSIterator(t *testing.T) {
+	testCases := append(commonTests(), test{
+		desc:   "graph:3←1←0→2→4",
+		order:  7,
+		edges:  [][2]uint{{1, 3}, {0, 1}, {0, 2}, {2, 4}},
+		entry:  0,
+		output: []uint{0, 1, 2, 3, 4},
+	})
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := newIndexed(tC.order, tC.edges)
+
+			it := graph.NewBFSIterator(g, tC.entry)
+			path := []uint{}
+			for vertex := range it.All() {
+				path = append(path, vertex)
+			}
+			if it.Err() != tC.err {
+				t.Fatalf("expected error: %v, got: %v", tC.err, it.Err())
+			}
+			if tC.err != nil {
+				return
+			}
+
+			if !reflect.DeepEqual(tC.output, path) {
+				t.Errorf("expected: %v, got: %v", tC.output, path)
+			}
+		})
+	}
+}
+
+func TestDFSIterator(t *testing.T) {
+	testCases := append(commonTests(), test{
+		desc:   "graph:4←3←0→1→2",
+		order:  7,
+		edges:  [][2]uint{{3, 4}, {0, 3}, {0, 1}, {1, 2}},
+		entry:  0,
+		output: []uint{0, 1, 2, 3, 4},
+	})
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := newIndexed(tC.order, tC.edges)
+
+			it := graph.NewDFSIterator(g, tC.entry)
+			path := []uint{}
+			for vertex, ok := it.Next(); ok; vertex, ok = it.Next() {
+				path = append(path, vertex)
+			}
+			if it.Err() != tC.err {
+				t.Fatalf("expected error: %v, got: %v", tC.err, it.Err())
+			}
+			if tC.err != nil {
+				return
+			}
+
+			if !reflect.DeepEqual(tC.output, path) {
+				t.Errorf("expected: %v, got: %v", tC.output, path)
+			}
+		})
+	}
+}
+
+func TestBFSSeqGrid(t *testing.T) {
+	g := graph.NewGrid()
+
+	amount := 0
+	for _, depth := range graph.BFSSeq(g, [2]int{0, 0}) {
+		if depth > 2 {
+			break
+		}
+		amount++
+	}
+
+	if expect := 13; amount != expect {
+		t.Errorf("expected: %v, got: %v", expect, amount)
+	}
+}
+
+func TestDFSSeqGrid(t *testing.T) {
+	g := graph.NewGrid()
+
+	next, stop := iter.Pull(graph.DFSSeq(g, [2]int{0, 0}))
+	defer stop()
+
+	// infinite DFS keeps walking in the direction of the last neighbor
+	expect := [][2]int{{0, 0}, {-1, 0}, {-2, 0}, {-3, 0}}
+	for _, vertex := range expect {
+		got, ok := next()
+		if !ok || got != vertex {
+			t.Fatalf("expected: %v, got: %v", vertex, got)
+		}
+	}
+}
+
+func TestSeqZip(t *testing.T) {
+	g := newIndexed(5, [][2]uint{{0, 1}, {0, 2}, {1, 3}, {2, 4}})
+
+	bfs, stop := iter.Pull2(graph.BFSSeq(g, 0))
+	defer stop()
+
+	pairs := [][2]uint{}
+	for vertex := range graph.DFSSeq(g, 0) {
+		other, _, ok := bfs()
+		if !ok {
+			break
+		}
+		pairs = append(pairs, [2]uint{vertex, other})
+	}
+
+	expect := [][2]uint{{0, 0}, {2, 1}, {4, 2}, {1, 3}, {3, 4}}
+	if !reflect.DeepEqual(expect, pairs) {
+		t.Errorf("expected: %v, got: %v", expect, pairs)
+	}
+}
@@ -29,6 +29,10 @@ func (g *Mapped[K]) Vertices() []K {
 	return vertices
 }
 
+func (g *Mapped[K]) Order() int {
+	return len(g.vertices)
+}
+
 func (g *Mapped[K]) AddVertices(vertices ...K) error {
 	for _, vertex := range vertices {
 		_, ok := g.vertices[vertex]
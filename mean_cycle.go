@@ -0,0 +1,311 @@
+package graph
+
+import "slices"
+
+// MinimumMeanCycle finds the cycle with the least average edge weight
+// using Karp's algorithm. Returns the average and the cycle, which starts
+// from its vertex that comes first in the order of Vertices,
+// the edge back to it is implied. Negative weights are allowed.
+// Memory usage is quadratic in the order of the graph.
+func MinimumMeanCycle[K comparable, N Number](g WeightedGraphReader[K, N]) (float64, []K, error) {
+	c, err := newCompact(g)
+	if err != nil {
+		return 0, nil, err
+	}
+	weights := make([][]N, c.order())
+	for u, vertex := range c.vertices {
+		if weights[u], err = edgeValues(g, vertex, g.Adjacency(vertex)); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	// dist[k][v] is the weight of the lightest walk of exactly k edges
+	// that ends at v and starts anywhere, via[k][v] is its last edge
+	n := c.order()
+	dist := make([][]N, n+1)
+	via := make([][]cycleEdge, n+1)
+	reached := make([][]bool, n+1)
+	for k := range dist {
+		dist[k] = make([]N, n)
+		via[k] = make([]cycleEdge, n)
+		reached[k] = make([]bool, n)
+	}
+	for v := range n {
+		reached[0][v] = true
+	}
+	for k := 1; k <= n; k++ {
+		for u, neighbors := range c.adj {
+			if !reached[k-1][u] {
+				continue
+			}
+			for i, v := range neighbors {
+				d := dist[k-1][u] + weights[u][i]
+				if !reached[k][v] || d < dist[k][v] {
+					dist[k][v] = d
+					via[k][v] = cycleEdge{u, i}
+					reached[k][v] = true
+				}
+			}
+		}
+	}
+
+	// the minimum mean is the least over v of the greatest over k of
+	// (dist[n][v] - dist[k][v]) / (n - k)
+	end := -1
+	var least float64
+	for v := range n {
+		if !reached[n][v] {
+			continue
+		}
+
+		var greatest float64
+		for k := range n {
+			if !reached[k][v] {
+				continue
+			}
+			mean := (float64(dist[n][v]) - float64(dist[k][v])) / float64(n-k)
+			if k == 0 || mean > greatest {
+				greatest = mean
+			}
+		}
+		if end == -1 || greatest < least {
+			least, end = greatest, v
+		}
+	}
+	if end == -1 {
+		return 0, nil, ErrAcyclic
+	}
+
+	// every cycle of the walk leading to the minimum has the minimum mean,
+	// the lightest one is taken to be safe from rounding
+	walk := make([]cycleEdge, n)
+	for k, v := n, end; k > 0; k-- {
+		walk[k-1] = via[k][v]
+		v = walk[k-1].from
+	}
+
+	var best []cycleEdge
+	var bestMean float64
+	position := make([]int, n)
+	for i := range position {
+		position[i] = -1
+	}
+	var stack []cycleEdge
+	for _, e := range walk {
+		v := c.adj[e.from][e.index]
+		if len(stack) == 0 {
+			position[e.from] = 0
+		}
+		stack = append(stack, e)
+
+		if p := position[v]; p != -1 {
+			var sum N
+			for _, ce := range stack[p:] {
+				sum += weights[ce.from][ce.index]
+				position[ce.from] = -1
+			}
+			if mean := float64(sum) / float64(len(stack)-p); best == nil || mean < bestMean {
+				best, bestMean = slices.Clone(stack[p:]), mean
+			}
+			stack = stack[:p]
+		}
+		position[v] = len(stack)
+	}
+
+	return bestMean, c.cycle(best), nil
+}
+
+// MaximumCycleRatio finds the cycle with the greatest ratio of its total
+// cost to its total time, such as the throughput bound of a dataflow graph.
+// Edge vectors hold the cost followed by the time. Time must not be negative
+// and must add up to a positive value along every cycle.
+// Returns the ratio and the cycle, which starts from its vertex that comes
+// first in the order of Vertices, the edge back to it is implied.
+func MaximumCycleRatio[K comparable, N Number](g MultiWeightedGraphReader[K, N]) (float64, []K, error) {
+	c, err := newCompact(g)
+	if err != nil {
+		return 0, nil, err
+	}
+	costs := make([][]N, c.order())
+	times := make([][]N, c.order())
+	for u, vertex := range c.vertices {
+		neighbors := g.Adjacency(vertex)
+		edges := make([][2]K, len(neighbors))
+		for i, neighbor := range neighbors {
+			edges[i] = [2]K{vertex, neighbor}
+		}
+		vectors := g.EdgesVectors(edges...)
+		if len(vectors) < len(edges) {
+			return 0, nil, ErrNilVertex
+		}
+
+		costs[u] = make([]N, len(edges))
+		times[u] = make([]N, len(edges))
+		for i, vector := range vectors {
+			if len(vector) != 2 {
+				return 0, nil, ErrDimension
+			}
+			if vector[1] < 0 {
+				return 0, nil, ErrNegativeWeight
+			}
+			costs[u][i], times[u][i] = vector[0], vector[1]
+		}
+	}
+
+	ratio := func(cycle []cycleEdge) (float64, float64, error) {
+		var cost, time N
+		for _, e := range cycle {
+			cost += costs[e.from][e.index]
+			time += times[e.from][e.index]
+		}
+		if time == 0 {
+			return 0, 0, ErrWeightRange
+		}
+		return float64(cost), float64(time), nil
+	}
+
+	cycle := anyCycle(c.adj)
+	if cycle == nil {
+		return 0, nil, ErrAcyclic
+	}
+	cost, time, err := ratio(cycle)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// a cycle with a greater ratio is negative when each edge weighs
+	// cost * edge time - time * edge cost, so the ratio grows until there
+	// is none. Weights are not divided to keep integer ones exact.
+	weights := make([][]float64, c.order())
+	for {
+		for u := range weights {
+			weights[u] = make([]float64, len(c.adj[u]))
+			for i := range weights[u] {
+				weights[u][i] = cost*float64(times[u][i]) - time*float64(costs[u][i])
+			}
+		}
+
+		next := negativeCycle(c.adj, weights)
+		if next == nil {
+			break
+		}
+		nextCost, nextTime, err := ratio(next)
+		if err != nil {
+			return 0, nil, err
+		}
+		if nextCost*time <= cost*nextTime {
+			break
+		}
+		cost, time, cycle = nextCost, nextTime, next
+	}
+
+	return cost / time, c.cycle(cycle), nil
+}
+
+// cycleEdge is the edge from a vertex of a compact graph
+// to its neighbor at the index.
+type cycleEdge struct {
+	from, index int
+}
+
+// cycle returns vertices of the cycle starting from the least one.
+func (c *compact[K]) cycle(edges []cycleEdge) []K {
+	least := 0
+	for i, e := range edges {
+		if e.from < edges[least].from {
+			least = i
+		}
+	}
+
+	vertices := make([]K, len(edges))
+	for i := range edges {
+		vertices[i] = c.vertices[edges[(least+i)%len(edges)].from]
+	}
+	return vertices
+}
+
+// anyCycle returns edges of a cycle found by depth first search,
+// nil if the graph is acyclic.
+func anyCycle(adj [][]int) []cycleEdge {
+	const (
+		unvisited = iota
+		active
+		finished
+	)
+	state := make([]int, len(adj))
+
+	for root := range adj {
+		if state[root] != unvisited {
+			continue
+		}
+
+		state[root] = active
+		stack := []cycleEdge{{root, 0}}
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			if top.index == len(adj[top.from]) {
+				state[top.from] = finished
+				stack = stack[:len(stack)-1]
+				continue
+			}
+			v := adj[top.from][top.index]
+
+			switch state[v] {
+			case unvisited:
+				state[v] = active
+				stack = append(stack, cycleEdge{v, 0})
+			case active:
+				// frames from v to the top hold the edges of the cycle
+				start := len(stack) - 1
+				for stack[start].from != v {
+					start--
+				}
+				return slices.Clone(stack[start:])
+			default:
+				top.index++
+			}
+		}
+	}
+	return nil
+}
+
+// negativeCycle returns edges of a cycle of negative weight found by
+// Bellman-Ford algorithm started from every vertex at once,
+// nil if there is no such cycle.
+func negativeCycle(adj [][]int, weights [][]float64) []cycleEdge {
+	n := len(adj)
+	dist := make([]float64, n)
+	via := make([]cycleEdge, n)
+
+	last := -1
+	for range n {
+		last = -1
+		for u, neighbors := range adj {
+			for i, v := range neighbors {
+				if d := dist[u] + weights[u][i]; d < dist[v] {
+					dist[v] = d
+					via[v] = cycleEdge{u, i}
+					last = v
+				}
+			}
+		}
+		if last == -1 {
+			return nil
+		}
+	}
+
+	// a vertex relaxed in the last pass leads back into the cycle
+	for range n {
+		last = via[last].from
+	}
+	var cycle []cycleEdge
+	for v := last; ; {
+		cycle = append(cycle, via[v])
+		v = via[v].from
+		if v == last {
+			break
+		}
+	}
+	slices.Reverse(cycle)
+	return cycle
+}
@@ -0,0 +1,139 @@
+package graph_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/axseem/graph"
+)
+
+func TestMinimumMeanCycle(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		edges []edge[int, int]
+		mean  float64
+		cycle []int
+		err   error
+	}{
+		{
+			desc:  "acyclic graph",
+			edges: []edge[int, int]{{0, 1, 1}, {1, 2, 1}, {0, 2, 1}},
+			err:   graph.ErrAcyclic,
+		},
+		{
+			desc:  "single cycle",
+			edges: []edge[int, int]{{0, 1, 1}, {1, 2, 2}, {2, 0, 6}, {2, 3, 0}},
+			mean:  3,
+			cycle: []int{0, 1, 2},
+		},
+		{
+			desc: "two cycles",
+			edges: []edge[int, int]{
+				{0, 1, 4}, {1, 0, 4},
+				{1, 2, 1}, {2, 3, 2}, {3, 1, 3},
+			},
+			mean:  2,
+			cycle: []int{1, 2, 3},
+		},
+		{
+			desc: "negative weights",
+			edges: []edge[int, int]{
+				{0, 1, -1}, {1, 0, -2},
+				{1, 2, -5}, {2, 3, 1}, {3, 1, 1},
+			},
+			mean:  -1.5,
+			cycle: []int{0, 1},
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := newWeighted([]int{0, 1, 2, 3}, tC.edges)
+
+			mean, cycle, err := graph.MinimumMeanCycle(g)
+			if err != tC.err {
+				t.Fatalf("expected error: %v, got: %v", tC.err, err)
+			}
+			if math.Abs(tC.mean-mean) > 1e-9 || !reflect.DeepEqual(tC.cycle, rotated(cycle)) {
+				t.Errorf("expected: %v %v, got: %v %v", tC.mean, tC.cycle, mean, cycle)
+			}
+		})
+	}
+}
+
+func TestMaximumCycleRatio(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		edges map[[2]string][]int
+		ratio float64
+		cycle []string
+		err   error
+	}{
+		{
+			desc:  "acyclic graph",
+			edges: map[[2]string][]int{{"a", "b"}: {1, 1}},
+			err:   graph.ErrAcyclic,
+		},
+		{
+			desc: "throughput",
+			edges: map[[2]string][]int{
+				{"a", "b"}: {2, 0}, {"b", "a"}: {3, 1},
+				{"b", "c"}: {3, 0}, {"c", "d"}: {4, 1}, {"d", "b"}: {1, 1},
+			},
+			ratio: 5,
+			cycle: []string{"a", "b"},
+		},
+		{
+			desc: "longer cycle wins",
+			edges: map[[2]string][]int{
+				{"a", "b"}: {1, 1}, {"b", "a"}: {1, 1},
+				{"b", "c"}: {9, 1}, {"c", "d"}: {9, 1}, {"d", "b"}: {0, 1},
+			},
+			ratio: 6,
+			cycle: []string{"b", "c", "d"},
+		},
+		{
+			desc:  "cycle without time",
+			edges: map[[2]string][]int{{"a", "b"}: {1, 0}, {"b", "a"}: {1, 0}},
+			err:   graph.ErrWeightRange,
+		},
+		{
+			desc:  "negative time",
+			edges: map[[2]string][]int{{"a", "b"}: {1, -1}, {"b", "a"}: {1, 2}},
+			err:   graph.ErrNegativeWeight,
+		},
+		{
+			desc:  "vector length mismatch",
+			edges: map[[2]string][]int{{"a", "b"}: {1}},
+			err:   graph.ErrDimension,
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := newVectored([]string{"a", "b", "c", "d"}, tC.edges)
+
+			ratio, cycle, err := graph.MaximumCycleRatio(g)
+			if err != tC.err {
+				t.Fatalf("expected error: %v, got: %v", tC.err, err)
+			}
+			if math.Abs(tC.ratio-ratio) > 1e-9 || !reflect.DeepEqual(tC.cycle, rotated(cycle)) {
+				t.Errorf("expected: %v %v, got: %v %v", tC.ratio, tC.cycle, ratio, cycle)
+			}
+		})
+	}
+}
+
+// rotated returns the cycle starting from its least vertex,
+// since the first one depends on the order of Vertices.
+func rotated[K int | string](cycle []K) []K {
+	if len(cycle) == 0 {
+		return nil
+	}
+	least := 0
+	for i, vertex := range cycle {
+		if vertex < cycle[least] {
+			least = i
+		}
+	}
+	return append(cycle[least:], cycle[:least]...)
+}
@@ -0,0 +1,215 @@
+package graph
+
+// Eccentricity returns for every vertex the greatest distance to any other vertex.
+// Distances follow edge directions, every vertex must reach every other one,
+// otherwise ErrDisconnected is returned.
+func Eccentricity[K comparable](g GraphReader[K]) (map[K]int, error) {
+	c, eccentricities, err := eccentricities(g)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[K]int, len(eccentricities))
+	for i, e := range eccentricities {
+		result[c.vertices[i]] = e
+	}
+	return result, nil
+}
+
+// Diameter returns the greatest eccentricity.
+func Diameter[K comparable](g GraphReader[K]) (int, error) {
+	_, eccentricities, err := eccentricities(g)
+	if err != nil {
+		return 0, err
+	}
+
+	var diameter int
+	for _, e := range eccentricities {
+		diameter = max(diameter, e)
+	}
+	return diameter, nil
+}
+
+// Radius returns the smallest eccentricity.
+func Radius[K comparable](g GraphReader[K]) (int, error) {
+	_, eccentricities, err := eccentricities(g)
+	if err != nil || len(eccentricities) == 0 {
+		return 0, err
+	}
+
+	radius := eccentricities[0]
+	for _, e := range eccentricities {
+		radius = min(radius, e)
+	}
+	return radius, nil
+}
+
+// Center returns vertices whose eccentricity equals the radius.
+func Center[K comparable](g GraphReader[K]) ([]K, error) {
+	return extremes(g, func(a, b int) bool { return a < b })
+}
+
+// Periphery returns vertices whose eccentricity equals the diameter.
+func Periphery[K comparable](g GraphReader[K]) ([]K, error) {
+	return extremes(g, func(a, b int) bool { return a > b })
+}
+
+// DiameterLowerBound estimates the diameter with a double sweep:
+// a breadth first search from an arbitrary vertex finds the farthest vertex,
+// whose eccentricity is returned. Takes two searches, whatever the graph size.
+// The graph is treated as undirected, so the bound holds for Diameter
+// only on graphs where every edge has its opposite.
+func DiameterLowerBound[K comparable](g GraphReader[K]) (int, error) {
+	c, err := newCompact(g)
+	if err != nil {
+		return 0, err
+	}
+
+	adj := c.undirected()
+	if len(adj) == 0 {
+		return 0, nil
+	}
+
+	dist := make([]int, len(adj))
+	far, _, reached := distances(adj, 0, dist, nil)
+	if reached != len(adj) {
+		return 0, ErrDisconnected
+	}
+	_, e, _ := distances(adj, far, dist, nil)
+	return e, nil
+}
+
+// DiameterIFUB computes the exact diameter with iFUB algorithm,
+// which usually needs a small amount of searches on real world graphs.
+// The graph is treated as undirected, so the result matches Diameter only
+// on graphs where every edge has its opposite. On others it is the diameter
+// of the undirected graph, which exists even when Diameter reports
+// ErrDisconnected.
+func DiameterIFUB[K comparable](g GraphReader[K]) (int, error) {
+	c, err := newCompact(g)
+	if err != nil {
+		return 0, err
+	}
+
+	adj := c.undirected()
+	n := len(adj)
+	if n == 0 {
+		return 0, nil
+	}
+
+	dist := make([]int, n)
+	parents := make([]int, n)
+
+	// start from the middle of a long path found by double sweep,
+	// such vertex tends to have low eccentricity
+	hub := 0
+	for u := range adj {
+		if len(adj[u]) > len(adj[hub]) {
+			hub = u
+		}
+	}
+	a, _, reached := distances(adj, hub, dist, nil)
+	if reached != n {
+		return 0, ErrDisconnected
+	}
+	b, length, _ := distances(adj, a, dist, parents)
+	middle := b
+	for range length / 2 {
+		middle = parents[middle]
+	}
+
+	_, level, _ := distances(adj, middle, dist, nil)
+	levels := make([][]int, level+1)
+	for u, d := range dist {
+		levels[d] = append(levels[d], u)
+	}
+
+	lower := max(length, level)
+	upper := 2 * level
+	other := make([]int, n)
+	for ; upper > lower; level-- {
+		var highest int
+		for _, u := range levels[level] {
+			_, e, _ := distances(adj, u, other, nil)
+			highest = max(highest, e)
+		}
+
+		lower = max(lower, highest)
+		if lower > 2*(level-1) {
+			return lower, nil
+		}
+		upper = 2 * (level - 1)
+	}
+	return lower, nil
+}
+
+func eccentricities[K comparable](g GraphReader[K]) (*compact[K], []int, error) {
+	c, err := newCompact(g)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	n := c.order()
+	result := make([]int, n)
+	dist := make([]int, n)
+	for u := range n {
+		_, e, reached := distances(c.adj, u, dist, nil)
+		if reached != n {
+			return nil, nil, ErrDisconnected
+		}
+		result[u] = e
+	}
+	return c, result, nil
+}
+
+func extremes[K comparable](g GraphReader[K], better func(a, b int) bool) ([]K, error) {
+	c, eccentricities, err := eccentricities(g)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []K{}
+	if len(eccentricities) == 0 {
+		return result, nil
+	}
+
+	target := eccentricities[0]
+	for _, e := range eccentricities {
+		if better(e, target) {
+			target = e
+		}
+	}
+	for i, e := range eccentricities {
+		if e == target {
+			result = append(result, c.vertices[i])
+		}
+	}
+	return result, nil
+}
+
+// distances fills dist with distances from source, unreachable vertices get -1.
+// If parents is not nil, it is filled with the previous vertex on a shortest path.
+// Returns the last reached vertex, its distance and the amount of reached vertices.
+func distances(adj [][]int, source int, dist, parents []int) (last, eccentricity, reached int) {
+	for i := range dist {
+		dist[i] = -1
+	}
+	dist[source] = 0
+
+	queue := []int{source}
+	for i := 0; i < len(queue); i++ {
+		u := queue[i]
+		for _, v := range adj[u] {
+			if dist[v] < 0 {
+				dist[v] = dist[u] + 1
+				if parents != nil {
+					parents[v] = u
+				}
+				queue = append(queue, v)
+			}
+		}
+	}
+
+	last = queue[len(queue)-1]
+	return last, dist[last], len(queue)
+}
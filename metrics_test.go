@@ -0,0 +1,179 @@
+package graph_test
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"testing"
+
+	"github.com/axseem/graph"
+)
+
+func TestMetrics(t *testing.T) {
+	testCases := []struct {
+		desc         string
+		order        uint
+		edges        [][2]uint
+		eccentricity map[uint]int
+		diameter     int
+		radius       int
+		center       []uint
+		periphery    []uint
+		err          error
+	}{
+		{
+			desc:         "trivial graph",
+			order:        1,
+			eccentricity: map[uint]int{0: 0},
+			center:       []uint{0},
+			periphery:    []uint{0},
+		},
+		{
+			desc:         "path",
+			order:        5,
+			edges:        undirected([][2]uint{{0, 1}, {1, 2}, {2, 3}, {3, 4}}),
+			eccentricity: map[uint]int{0: 4, 1: 3, 2: 2, 3: 3, 4: 4},
+			diameter:     4,
+			radius:       2,
+			center:       []uint{2},
+			periphery:    []uint{0, 4},
+		},
+		{
+			desc:         "3 vertices cycled directed graph",
+			order:        3,
+			edges:        [][2]uint{{0, 1}, {1, 2}, {2, 0}},
+			eccentricity: map[uint]int{0: 2, 1: 2, 2: 2},
+			diameter:     2,
+			radius:       2,
+			center:       []uint{0, 1, 2},
+			periphery:    []uint{0, 1, 2},
+		},
+		{
+			desc:  "graph:0→1",
+			order: 2,
+			edges: [][2]uint{{0, 1}},
+			err:   graph.ErrDisconnected,
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := newIndexed(tC.order, tC.edges)
+
+			eccentricity, err := graph.Eccentricity(g)
+			if err != tC.err {
+				t.Fatalf("expected error: %v, got: %v", tC.err, err)
+			}
+			if err != nil {
+				return
+			}
+			if !reflect.DeepEqual(tC.eccentricity, eccentricity) {
+				t.Errorf("expected eccentricity: %v, got: %v", tC.eccentricity, eccentricity)
+			}
+
+			if diameter, _ := graph.Diameter(g); diameter != tC.diameter {
+				t.Errorf("expected diameter: %v, got: %v", tC.diameter, diameter)
+			}
+			if radius, _ := graph.Radius(g); radius != tC.radius {
+				t.Errorf("expected radius: %v, got: %v", tC.radius, radius)
+			}
+			if center, _ := graph.Center(g); !reflect.DeepEqual(tC.center, center) {
+				t.Errorf("expected center: %v, got: %v", tC.center, center)
+			}
+			if periphery, _ := graph.Periphery(g); !reflect.DeepEqual(tC.periphery, periphery) {
+				t.Errorf("expected periphery: %v, got: %v", tC.periphery, periphery)
+			}
+		})
+	}
+}
+
+func TestMetricsInfiniteGraph(t *testing.T) {
+	if _, err := graph.Diameter(graph.NewGrid()); err != graph.ErrInfiniteGraph {
+		t.Errorf("expected: %v, got: %v", graph.ErrInfiniteGraph, err)
+	}
+	if _, err := graph.DiameterIFUB(graph.NewGrid()); err != graph.ErrInfiniteGraph {
+		t.Errorf("expected: %v, got: %v", graph.ErrInfiniteGraph, err)
+	}
+}
+
+func TestDiameterIFUB(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 0))
+	for i := range 50 {
+		order := uint(2 + rng.IntN(40))
+
+		// random spanning tree keeps the graph connected
+		edges := [][2]uint{}
+		for v := uint(1); v < order; v++ {
+			edges = append(edges, [2]uint{uint(rng.IntN(int(v))), v})
+		}
+		for range rng.IntN(int(order)) {
+			u, v := uint(rng.IntN(int(order))), uint(rng.IntN(int(order)))
+			if u != v {
+				edges = append(edges, [2]uint{u, v})
+			}
+		}
+		g := newIndexed(order, undirected(edges))
+
+		expect, err := graph.Diameter(g)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		diameter, err := graph.DiameterIFUB(g)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diameter != expect {
+			t.Errorf("graph %d expected: %v, got: %v", i, expect, diameter)
+		}
+
+		bound, err := graph.DiameterLowerBound(g)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bound > expect || bound < (expect+1)/2 {
+			t.Errorf("graph %d expected bound in [%v, %v], got: %v", i, (expect+1)/2, expect, bound)
+		}
+	}
+
+	g := newIndexed(4, [][2]uint{{0, 1}, {2, 3}})
+	if _, err := graph.DiameterIFUB(g); err != graph.ErrDisconnected {
+		t.Errorf("expected: %v, got: %v", graph.ErrDisconnected, err)
+	}
+}
+
+func TestDiameterIFUBDirected(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		edges    [][2]uint
+		diameter int
+		err      error
+		ifub     int
+	}{
+		{
+			desc:     "directed cycle",
+			edges:    [][2]uint{{0, 1}, {1, 2}, {2, 0}},
+			diameter: 2,
+			ifub:     1,
+		},
+		{
+			desc:  "directed path",
+			edges: [][2]uint{{0, 1}, {1, 2}},
+			err:   graph.ErrDisconnected,
+			ifub:  2,
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := newIndexed(3, tC.edges)
+
+			diameter, err := graph.Diameter(g)
+			if err != tC.err || diameter != tC.diameter {
+				t.Errorf("expected Diameter: %v %v, got: %v %v", tC.diameter, tC.err, diameter, err)
+			}
+			ifub, err := graph.DiameterIFUB(g)
+			if err != nil || ifub != tC.ifub {
+				t.Errorf("expected DiameterIFUB: %v, got: %v %v", tC.ifub, ifub, err)
+			}
+		})
+	}
+}
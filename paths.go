@@ -0,0 +1,80 @@
+package graph
+
+// AllSimplePaths passes every path without repeated vertices between two
+// vertices to while, the function exits if while returns false.
+// Paths are not extended beyond maxLen edges, zero means no limit.
+// Paths are found in depth first order, passed slices may be kept.
+func AllSimplePaths[K comparable](g Graph[K], from, to K, maxLen uint, while func(path []K) bool, opts ...SearchOptions) error {
+	b := newBudget(opts)
+	if g.Adjacency(from) == nil || g.Adjacency(to) == nil {
+		return ErrNilVertex
+	}
+
+	type frame struct {
+		vertex    K
+		neighbors []K
+	}
+	var stack []frame
+	onPath := make(map[K]struct{})
+	var cut bool
+
+	// enter extends the path with the vertex.
+	// Returns false if while asked to stop.
+	enter := func(vertex K) (bool, error) {
+		depth := uint(len(stack))
+		if !b.reaches(depth) {
+			cut = true
+			return true, nil
+		}
+		if err := b.visit(); err != nil {
+			return false, err
+		}
+
+		if vertex == to {
+			path := make([]K, depth+1)
+			for i, f := range stack {
+				path[i] = f.vertex
+			}
+			path[depth] = vertex
+			return while(path), nil
+		}
+		if maxLen != 0 && depth == maxLen {
+			return true, nil
+		}
+
+		n := g.Adjacency(vertex)
+		if n == nil {
+			return false, ErrNilVertex
+		}
+		stack = append(stack, frame{vertex, n})
+		onPath[vertex] = struct{}{}
+		return true, nil
+	}
+
+	more, err := enter(from)
+	for more && err == nil && len(stack) > 0 {
+		top := &stack[len(stack)-1]
+
+		// neighbors are explored from the last one, as DFS pops them from its stack
+		if len(top.neighbors) == 0 {
+			delete(onPath, top.vertex)
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		neighbor := top.neighbors[len(top.neighbors)-1]
+		top.neighbors = top.neighbors[:len(top.neighbors)-1]
+
+		if _, ok := onPath[neighbor]; ok {
+			continue
+		}
+		more, err = enter(neighbor)
+	}
+
+	if err != nil {
+		return err
+	}
+	if more && cut {
+		return ErrBudgetExceeded
+	}
+	return nil
+}
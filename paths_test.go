@@ -0,0 +1,117 @@
+package graph_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/axseem/graph"
+)
+
+func TestAllSimplePaths(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		order    uint
+		edges    [][2]uint
+		from, to uint
+		maxLen   uint
+		output   [][]uint
+		err      error
+	}{
+		{
+			desc:  "nil vertex",
+			order: 1,
+			from:  0,
+			to:    1,
+			err:   graph.ErrNilVertex,
+		},
+		{
+			desc:   "trivial path",
+			order:  2,
+			edges:  undirected([][2]uint{{0, 1}}),
+			from:   0,
+			to:     0,
+			output: [][]uint{{0}},
+		},
+		{
+			desc:  "no path",
+			order: 2,
+			edges: [][2]uint{{1, 0}},
+			from:  0,
+			to:    1,
+		},
+		{
+			desc:   "diamond",
+			order:  4,
+			edges:  [][2]uint{{0, 1}, {0, 2}, {1, 3}, {2, 3}, {1, 2}},
+			from:   0,
+			to:     3,
+			output: [][]uint{{0, 2, 3}, {0, 1, 2, 3}, {0, 1, 3}},
+		},
+		{
+			desc:   "cycles are not followed",
+			order:  3,
+			edges:  undirected([][2]uint{{0, 1}, {1, 2}, {2, 0}}),
+			from:   0,
+			to:     2,
+			output: [][]uint{{0, 2}, {0, 1, 2}},
+		},
+		{
+			desc:   "max length",
+			order:  4,
+			edges:  [][2]uint{{0, 1}, {0, 2}, {1, 3}, {2, 3}, {1, 2}},
+			from:   0,
+			to:     3,
+			maxLen: 2,
+			output: [][]uint{{0, 2, 3}, {0, 1, 3}},
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := newIndexed(tC.order, tC.edges)
+
+			var output [][]uint
+			err := graph.AllSimplePaths(g, tC.from, tC.to, tC.maxLen, func(path []uint) bool {
+				output = append(output, path)
+				return true
+			})
+			if err != tC.err {
+				t.Fatalf("expected error: %v, got: %v", tC.err, err)
+			}
+			if !reflect.DeepEqual(tC.output, output) {
+				t.Errorf("expected: %v, got: %v", tC.output, output)
+			}
+		})
+	}
+}
+
+func TestAllSimplePathsStop(t *testing.T) {
+	g := newIndexed(4, [][2]uint{{0, 1}, {0, 2}, {1, 3}, {2, 3}, {1, 2}})
+
+	var count int
+	err := graph.AllSimplePaths(g, 0, 3, 0, func(path []uint) bool {
+		count++
+		return false
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("expected: %v, got: %v", 1, count)
+	}
+}
+
+func TestAllSimplePathsBudget(t *testing.T) {
+	g := newIndexed(4, [][2]uint{{0, 1}, {0, 2}, {1, 3}, {2, 3}, {1, 2}})
+
+	var output [][]uint
+	err := graph.AllSimplePaths(g, 0, 3, 0, func(path []uint) bool {
+		output = append(output, path)
+		return true
+	}, graph.SearchOptions{MaxDepth: 2})
+	if err != graph.ErrBudgetExceeded {
+		t.Errorf("expected: %v, got: %v", graph.ErrBudgetExceeded, err)
+	}
+	if expect := [][]uint{{0, 2, 3}, {0, 1, 3}}; !reflect.DeepEqual(expect, output) {
+		t.Errorf("expected: %v, got: %v", expect, output)
+	}
+}
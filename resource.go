@@ -0,0 +1,160 @@
+package graph
+
+import (
+	"container/heap"
+	"slices"
+)
+
+// VectorPath is a sequence of vertices from the first to the last one,
+// along with the sum of vectors of its edges.
+type VectorPath[K comparable, N Number] struct {
+	Vertices []K
+	Costs    []N
+}
+
+// ResourceConstrainedPaths finds the Pareto front of paths between two
+// vertices, paths none of which is worse than another one in every value.
+// The first value of edge vectors is the cost, the rest are consumptions
+// of resources bounded by limits, so vectors are one value longer than limits.
+// Paths are sorted by cost. Values must not be negative.
+// Paths returned along with ErrBudgetExceeded belong to the front,
+// but the front may miss some.
+func ResourceConstrainedPaths[K comparable, N Number](g MultiWeightedGraph[K, N], from, to K, limits []N, opts ...SearchOptions) ([]VectorPath[K, N], error) {
+	b := newBudget(opts)
+
+	// labels are partial paths, each one extends its parent by a single edge
+	labels := []resourceLabel[K, N]{{vertex: from, costs: make([]N, len(limits)+1), parent: -1}}
+	queue := &labelQueue[K, N]{labels: &labels, items: []int{0}}
+	settled := make(map[K][]int)
+	var front []VectorPath[K, N]
+	var cut bool
+
+	dominated := func(vertex K, costs []N) bool {
+		for _, i := range settled[vertex] {
+			if dominates(labels[i].costs, costs) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for queue.Len() > 0 {
+		i := heap.Pop(queue).(int)
+		label := labels[i]
+		if dominated(label.vertex, label.costs) {
+			continue
+		}
+
+		if !b.reaches(label.hops) {
+			cut = true
+			continue
+		}
+		if err := b.visit(); err != nil {
+			return front, err
+		}
+		settled[label.vertex] = append(settled[label.vertex], i)
+
+		if label.vertex == to {
+			path := []K{to}
+			for j := label.parent; j != -1; j = labels[j].parent {
+				path = append(path, labels[j].vertex)
+			}
+			slices.Reverse(path)
+			front = append(front, VectorPath[K, N]{path, label.costs})
+			continue
+		}
+
+		n := g.Adjacency(label.vertex)
+		if n == nil {
+			return nil, ErrNilVertex
+		}
+		edges := make([][2]K, len(n))
+		for j, neighbor := range n {
+			edges[j] = [2]K{label.vertex, neighbor}
+		}
+		vectors := g.EdgesVectors(edges...)
+		if len(vectors) < len(edges) {
+			return nil, ErrNilVertex
+		}
+
+	neighbors:
+		for j, neighbor := range n {
+			if len(vectors[j]) != len(label.costs) {
+				return nil, ErrDimension
+			}
+
+			costs := make([]N, len(label.costs))
+			for k, value := range vectors[j] {
+				if value < 0 {
+					return nil, ErrNegativeWeight
+				}
+				costs[k] = label.costs[k] + value
+				if k > 0 && costs[k] > limits[k-1] {
+					continue neighbors
+				}
+			}
+			if dominated(neighbor, costs) {
+				continue
+			}
+
+			labels = append(labels, resourceLabel[K, N]{neighbor, costs, label.hops + 1, i})
+			heap.Push(queue, len(labels)-1)
+		}
+	}
+
+	if cut {
+		return front, ErrBudgetExceeded
+	}
+	if len(front) == 0 {
+		return nil, ErrNoPath
+	}
+	return front, nil
+}
+
+type resourceLabel[K comparable, N Number] struct {
+	vertex K
+	costs  []N
+	hops   uint
+	parent int
+}
+
+// dominates reports whether a is not worse than b in every value.
+func dominates[N Number](a, b []N) bool {
+	for i := range a {
+		if a[i] > b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// labelQueue implements heap.Interface over label indexes,
+// labels are popped in lexicographic order of their costs,
+// so a label is never popped before the ones dominating it.
+type labelQueue[K comparable, N Number] struct {
+	labels *[]resourceLabel[K, N]
+	items  []int
+}
+
+func (q *labelQueue[K, N]) Len() int {
+	return len(q.items)
+}
+
+func (q *labelQueue[K, N]) Less(i, j int) bool {
+	labels := *q.labels
+	return slices.Compare(labels[q.items[i]].costs, labels[q.items[j]].costs) < 0
+}
+
+func (q *labelQueue[K, N]) Swap(i, j int) {
+	q.items[i], q.items[j] = q.items[j], q.items[i]
+}
+
+func (q *labelQueue[K, N]) Push(x any) {
+	q.items = append(q.items, x.(int))
+}
+
+func (q *labelQueue[K, N]) Pop() any {
+	item := q.items[len(q.items)-1]
+	q.items = q.items[:len(q.items)-1]
+	return item
+}
@@ -0,0 +1,138 @@
+package graph_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/axseem/graph"
+)
+
+func TestResourceConstrainedPaths(t *testing.T) {
+	// edges carry cost and time
+	roads := map[[2]string][]int{
+		{"a", "b"}: {1, 10},
+		{"a", "c"}: {10, 1},
+		{"b", "d"}: {1, 10},
+		{"c", "d"}: {10, 1},
+		{"b", "c"}: {2, 2},
+		{"c", "b"}: {2, 2},
+		{"d", "a"}: {0, 0},
+	}
+
+	testCases := []struct {
+		desc     string
+		edges    map[[2]string][]int
+		from, to string
+		limits   []int
+		output   []graph.VectorPath[string, int]
+		err      error
+	}{
+		{
+			desc:   "nil vertex",
+			edges:  roads,
+			from:   "x",
+			to:     "d",
+			limits: []int{100},
+			err:    graph.ErrNilVertex,
+		},
+		{
+			desc:   "trivial path",
+			edges:  roads,
+			from:   "a",
+			to:     "a",
+			limits: []int{100},
+			output: []graph.VectorPath[string, int]{{[]string{"a"}, []int{0, 0}}},
+		},
+		{
+			desc:   "whole front",
+			edges:  roads,
+			from:   "a",
+			to:     "d",
+			limits: []int{100},
+			output: []graph.VectorPath[string, int]{
+				{[]string{"a", "b", "d"}, []int{2, 20}},
+				{[]string{"a", "b", "c", "d"}, []int{13, 13}},
+				{[]string{"a", "c", "d"}, []int{20, 2}},
+			},
+		},
+		{
+			desc:   "time limit",
+			edges:  roads,
+			from:   "a",
+			to:     "d",
+			limits: []int{15},
+			output: []graph.VectorPath[string, int]{
+				{[]string{"a", "b", "c", "d"}, []int{13, 13}},
+				{[]string{"a", "c", "d"}, []int{20, 2}},
+			},
+		},
+		{
+			desc:   "no path within limits",
+			edges:  roads,
+			from:   "a",
+			to:     "d",
+			limits: []int{1},
+			err:    graph.ErrNoPath,
+		},
+		{
+			desc:   "vector length mismatch",
+			edges:  roads,
+			from:   "a",
+			to:     "d",
+			limits: []int{1, 1},
+			err:    graph.ErrDimension,
+		},
+		{
+			desc:   "negative value",
+			edges:  map[[2]string][]int{{"a", "d"}: {-1, 0}},
+			from:   "a",
+			to:     "d",
+			limits: []int{1},
+			err:    graph.ErrNegativeWeight,
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := newVectored([]string{"a", "b", "c", "d"}, tC.edges)
+
+			output, err := graph.ResourceConstrainedPaths(g, tC.from, tC.to, tC.limits)
+			if err != tC.err {
+				t.Fatalf("expected error: %v, got: %v", tC.err, err)
+			}
+			if !reflect.DeepEqual(tC.output, output) {
+				t.Errorf("expected: %v, got: %v", tC.output, output)
+			}
+		})
+	}
+}
+
+func TestResourceConstrainedPathsBudget(t *testing.T) {
+	g := newVectored([]string{"a", "b", "c"}, map[[2]string][]int{
+		{"a", "b"}: {1, 1},
+		{"b", "c"}: {1, 1},
+	})
+
+	_, err := graph.ResourceConstrainedPaths(g, "a", "c", []int{10}, graph.SearchOptions{MaxDepth: 1})
+	if err != graph.ErrBudgetExceeded {
+		t.Errorf("expected: %v, got: %v", graph.ErrBudgetExceeded, err)
+	}
+}
+
+func TestResourceConstrainedPathsPartial(t *testing.T) {
+	g := newVectored([]string{"a", "b", "c", "d"}, map[[2]string][]int{
+		{"a", "d"}: {1, 10},
+		{"a", "b"}: {5, 1},
+		{"b", "c"}: {1, 1},
+		{"c", "d"}: {1, 1},
+	})
+
+	// the direct path is settled before the budget runs out
+	output, err := graph.ResourceConstrainedPaths(g, "a", "d", []int{100}, graph.SearchOptions{MaxVisited: 3})
+	if err != graph.ErrBudgetExceeded {
+		t.Errorf("expected: %v, got: %v", graph.ErrBudgetExceeded, err)
+	}
+	expect := []graph.VectorPath[string, int]{{[]string{"a", "d"}, []int{1, 10}}}
+	if !reflect.DeepEqual(expect, output) {
+		t.Errorf("expected: %v, got: %v", expect, output)
+	}
+}
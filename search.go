@@ -2,7 +2,8 @@ package graph
 
 // Each time a vertex is visited, the while function is triggered.
 // The function exits if while returns false or there are no more vertices.
-func BFS[K comparable](g Graph[K], entry K, while func(vertex K, depth uint) bool) error {
+func BFS[K comparable](g Graph[K], entry K, while func(vertex K, depth uint) bool, opts ...SearchOptions) error {
+	b := newBudget(opts)
 	queue := []K{entry}
 	visited := make(map[K]struct{})
 	var depth, depthCounter uint
@@ -17,6 +18,13 @@ func BFS[K comparable](g Graph[K], entry K, while func(vertex K, depth uint) boo
 			continue
 		}
 
+		if !b.reaches(depth) {
+			return ErrBudgetExceeded
+		}
+		if err := b.visit(); err != nil {
+			return err
+		}
+
 		if !while(bottom, depth) {
 			return nil
 		}
@@ -42,29 +50,106 @@ func BFS[K comparable](g Graph[K], entry K, while func(vertex K, depth uint) boo
 
 // Each time a vertex is visited, the while function is triggered.
 // The function exits if while returns false or there are no more vertices.
-func DFS[K comparable](g Graph[K], entry K, while func(vertex K) bool) error {
-	queue := []K{entry}
+func DFS[K comparable](g Graph[K], entry K, while func(vertex K) bool, opts ...SearchOptions) error {
+	type item struct {
+		vertex K
+		depth  uint
+	}
+
+	b := newBudget(opts)
+	queue := []item{{vertex: entry}}
 	visited := make(map[K]struct{})
+	// vertices skipped for being too deep, they may be reached by a shorter path later
+	var cut []K
 
 	for len(queue) > 0 {
 		top := queue[len(queue)-1]
 		queue = queue[:len(queue)-1]
 
-		if _, ok := visited[top]; ok {
+		if _, ok := visited[top.vertex]; ok {
 			continue
 		}
 
-		if !while(top) {
+		if !b.reaches(top.depth) {
+			cut = append(cut, top.vertex)
+			continue
+		}
+		if err := b.visit(); err != nil {
+			return err
+		}
+
+		if !while(top.vertex) {
 			return nil
 		}
 
-		n := g.Adjacency(top)
+		n := g.Adjacency(top.vertex)
 		if n == nil {
 			return ErrNilVertex
 		}
 
-		queue = append(queue, n...)
-		visited[top] = struct{}{}
+		for _, neighbor := range n {
+			queue = append(queue, item{neighbor, top.depth + 1})
+		}
+		visited[top.vertex] = struct{}{}
+	}
+
+	for _, vertex := range cut {
+		if _, ok := visited[vertex]; !ok {
+			return ErrBudgetExceeded
+		}
+	}
+	return nil
+}
+
+// MultiBFS runs BFS from all entries at once, so every vertex is claimed by
+// the closest entry. Ties are resolved in favour of the entry listed first.
+// Each time a vertex is visited, the while function is triggered with
+// the entry that claimed it and the distance to that entry.
+// The function exits if while returns false or there are no more vertices.
+func MultiBFS[K comparable](g Graph[K], entries []K, while func(vertex, source K, depth uint) bool, opts ...SearchOptions) error {
+	type claim struct {
+		vertex, source K
+		depth          uint
+	}
+
+	b := newBudget(opts)
+	queue := make([]claim, 0, len(entries))
+	visited := make(map[K]struct{})
+	for _, entry := range entries {
+		if _, ok := visited[entry]; ok {
+			continue
+		}
+		queue = append(queue, claim{entry, entry, 0})
+		visited[entry] = struct{}{}
+	}
+
+	for len(queue) > 0 {
+		bottom := queue[0]
+		queue = queue[1:]
+
+		if !b.reaches(bottom.depth) {
+			return ErrBudgetExceeded
+		}
+		if err := b.visit(); err != nil {
+			return err
+		}
+
+		if !while(bottom.vertex, bottom.source, bottom.depth) {
+			return nil
+		}
+
+		n := g.Adjacency(bottom.vertex)
+		if n == nil {
+			return ErrNilVertex
+		}
+
+		for _, neighbor := range n {
+			if _, ok := visited[neighbor]; ok {
+				continue
+			}
+			queue = append(queue, claim{neighbor, bottom.source, bottom.depth + 1})
+			visited[neighbor] = struct{}{}
+		}
 	}
 
 	return nil
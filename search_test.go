@@ -207,3 +207,111 @@ func TestBFSDepthGrid(t *testing.T) {
 		t.Errorf("expected: %v, got: %v", expect, amount)
 	}
 }
+
+func TestMultiBFS(t *testing.T) {
+	type claim struct {
+		source uint
+		depth  uint
+	}
+
+	testCases := []struct {
+		desc    string
+		order   uint
+		edges   [][2]uint
+		entries []uint
+		output  map[uint]claim
+		err     error
+	}{
+		{
+			desc:    "empty graph",
+			order:   0,
+			entries: []uint{0},
+			output:  map[uint]claim{0: {0, 0}},
+			err:     graph.ErrNilVertex,
+		},
+		{
+			desc:    "no entries",
+			order:   2,
+			entries: []uint{},
+			output:  map[uint]claim{},
+		},
+		{
+			desc:    "path claimed from both ends",
+			order:   5,
+			edges:   [][2]uint{{0, 1}, {1, 0}, {1, 2}, {2, 1}, {2, 3}, {3, 2}, {3, 4}, {4, 3}},
+			entries: []uint{0, 4},
+			output:  map[uint]claim{0: {0, 0}, 1: {0, 1}, 2: {0, 2}, 3: {4, 1}, 4: {4, 0}},
+		},
+		{
+			desc:    "tie goes to first entry",
+			order:   3,
+			edges:   [][2]uint{{0, 1}, {2, 1}},
+			entries: []uint{2, 0},
+			output:  map[uint]claim{0: {0, 0}, 1: {2, 1}, 2: {2, 0}},
+		},
+		{
+			desc:    "duplicated entry",
+			order:   2,
+			edges:   [][2]uint{{0, 1}},
+			entries: []uint{0, 0},
+			output:  map[uint]claim{0: {0, 0}, 1: {0, 1}},
+		},
+		{
+			desc:    "unreachable vertex",
+			order:   3,
+			edges:   [][2]uint{{0, 1}},
+			entries: []uint{0},
+			output:  map[uint]claim{0: {0, 0}, 1: {0, 1}},
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := graph.NewIndexed[uint]()
+
+			if err := g.AddVertices(tC.order); err != nil {
+				panic(err)
+			}
+
+			if err := g.AddEdges(tC.edges...); err != nil {
+				panic(err)
+			}
+
+			claims := map[uint]claim{}
+			err := graph.MultiBFS(g, tC.entries, func(vertex, source, depth uint) bool {
+				claims[vertex] = claim{source, depth}
+				return true
+			})
+			if err != tC.err {
+				t.Fatalf("expected error: %v, got: %v", tC.err, err)
+			}
+
+			if !reflect.DeepEqual(tC.output, claims) {
+				t.Errorf("expected: %v, got: %v", tC.output, claims)
+			}
+		})
+	}
+}
+
+func TestMultiBFSGrid(t *testing.T) {
+	g := graph.NewGrid()
+
+	territory := map[[2]int]int{}
+	err := graph.MultiBFS(g, [][2]int{{0, 0}, {4, 0}}, func(vertex, source [2]int, depth uint) bool {
+		if depth > 2 {
+			return false
+		}
+
+		territory[source]++
+		return true
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	// (2, 0) is equally far from both entries and goes to the first one
+	expect := map[[2]int]int{{0, 0}: 13, {4, 0}: 12}
+	if !reflect.DeepEqual(expect, territory) {
+		t.Errorf("expected: %v, got: %v", expect, territory)
+	}
+}
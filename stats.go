@@ -0,0 +1,140 @@
+package graph
+
+import "math"
+
+// Statistics summarises the structure of a finite graph.
+// Parallel edges are counted separately.
+type Statistics struct {
+	Order int
+	// Amount of edges.
+	Size int
+	// Maps degree to the amount of vertices with that degree.
+	InDegrees  map[int]int
+	OutDegrees map[int]int
+	// Fraction of all possible edges present in the graph.
+	Density float64
+	// Fraction of edges whose reverse edge is present too.
+	Reciprocity float64
+	// Pearson correlation between the out-degree of edge source
+	// and the in-degree of edge target. NaN if any of them is constant.
+	Assortativity float64
+	// Amount of weakly connected components.
+	Components int
+}
+
+// Size returns the amount of edges in the graph.
+func Size[K comparable](g GraphReader[K]) (int, error) {
+	c, err := newCompact(g)
+	if err != nil {
+		return 0, err
+	}
+	return c.size(), nil
+}
+
+// Stats collects Statistics of the graph.
+func Stats[K comparable](g GraphReader[K]) (*Statistics, error) {
+	c, err := newCompact(g)
+	if err != nil {
+		return nil, err
+	}
+
+	n := c.order()
+	s := &Statistics{
+		Order:         n,
+		Size:          c.size(),
+		InDegrees:     make(map[int]int),
+		OutDegrees:    make(map[int]int),
+		Assortativity: math.NaN(),
+	}
+
+	in := c.transpose()
+	for u := range n {
+		s.InDegrees[len(in[u])]++
+		s.OutDegrees[len(c.adj[u])]++
+	}
+
+	if n > 1 {
+		s.Density = float64(s.Size) / float64(n*(n-1))
+	}
+
+	if s.Size > 0 {
+		edges := make(map[[2]int]struct{}, s.Size)
+		for u, neighbors := range c.adj {
+			for _, v := range neighbors {
+				edges[[2]int{u, v}] = struct{}{}
+			}
+		}
+
+		var reciprocal int
+		var sx, sy, sxx, syy, sxy float64
+		for u, neighbors := range c.adj {
+			for _, v := range neighbors {
+				if _, ok := edges[[2]int{v, u}]; ok {
+					reciprocal++
+				}
+
+				x, y := float64(len(c.adj[u])), float64(len(in[v]))
+				sx += x
+				sy += y
+				sxx += x * x
+				syy += y * y
+				sxy += x * y
+			}
+		}
+		s.Reciprocity = float64(reciprocal) / float64(s.Size)
+
+		m := float64(s.Size)
+		covariance := sxy/m - sx/m*sy/m
+		deviation := math.Sqrt((sxx/m - sx/m*sx/m) * (syy/m - sy/m*sy/m))
+		if deviation > 1e-12 {
+			s.Assortativity = covariance / deviation
+		}
+	}
+
+	labels := components(c.undirected())
+	for _, label := range labels {
+		s.Components = max(s.Components, label+1)
+	}
+
+	return s, nil
+}
+
+func (c *compact[K]) size() int {
+	var size int
+	for _, neighbors := range c.adj {
+		size += len(neighbors)
+	}
+	return size
+}
+
+// components labels vertices of symmetric adjacency with the index
+// of their connected component, in order of the first vertex.
+func components(adj [][]int) []int {
+	labels := make([]int, len(adj))
+	for i := range labels {
+		labels[i] = -1
+	}
+
+	var label int
+	var queue []int
+	for s := range adj {
+		if labels[s] >= 0 {
+			continue
+		}
+
+		labels[s] = label
+		queue = append(queue[:0], s)
+		for len(queue) > 0 {
+			u := queue[len(queue)-1]
+			queue = queue[:len(queue)-1]
+			for _, v := range adj[u] {
+				if labels[v] < 0 {
+					labels[v] = label
+					queue = append(queue, v)
+				}
+			}
+		}
+		label++
+	}
+	return labels
+}
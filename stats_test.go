@@ -0,0 +1,96 @@
+package graph_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/axseem/graph"
+)
+
+func TestStats(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		vertices []string
+		edges    [][2]string
+		output   graph.Statistics
+	}{
+		{
+			desc:     "empty graph",
+			vertices: []string{},
+			output: graph.Statistics{
+				InDegrees:     map[int]int{},
+				OutDegrees:    map[int]int{},
+				Assortativity: math.NaN(),
+			},
+		},
+		{
+			desc:     "dependencies",
+			vertices: []string{"app", "http", "json", "log", "tool"},
+			edges: [][2]string{
+				{"app", "http"}, {"app", "json"}, {"app", "log"},
+				{"http", "log"}, {"json", "log"},
+			},
+			output: graph.Statistics{
+				Order:         5,
+				Size:          5,
+				InDegrees:     map[int]int{0: 2, 1: 2, 3: 1},
+				OutDegrees:    map[int]int{0: 2, 1: 2, 3: 1},
+				Density:       0.25,
+				Reciprocity:   0,
+				Assortativity: -2.0 / 3,
+				Components:    2,
+			},
+		},
+		{
+			desc:     "mutual",
+			vertices: []string{"a", "b", "c"},
+			edges:    [][2]string{{"a", "b"}, {"b", "a"}, {"b", "c"}},
+			output: graph.Statistics{
+				Order:         3,
+				Size:          3,
+				InDegrees:     map[int]int{1: 3},
+				OutDegrees:    map[int]int{0: 1, 1: 1, 2: 1},
+				Density:       0.5,
+				Reciprocity:   2.0 / 3,
+				Assortativity: math.NaN(),
+				Components:    1,
+			},
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := graph.NewMapped[string]()
+			if err := g.AddVertices(tC.vertices...); err != nil {
+				panic(err)
+			}
+			if err := g.AddEdges(tC.edges...); err != nil {
+				panic(err)
+			}
+
+			s, err := graph.Stats(g)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expect := tC.output
+			if math.IsNaN(expect.Assortativity) != math.IsNaN(s.Assortativity) ||
+				!math.IsNaN(expect.Assortativity) && math.Abs(expect.Assortativity-s.Assortativity) > 1e-9 {
+				t.Errorf("expected assortativity: %v, got: %v", expect.Assortativity, s.Assortativity)
+			}
+			expect.Assortativity, s.Assortativity = 0, 0
+			if !reflect.DeepEqual(expect, *s) {
+				t.Errorf("expected: %+v, got: %+v", expect, *s)
+			}
+
+			size, err := graph.Size(g)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if size != tC.output.Size {
+				t.Errorf("expected size: %v, got: %v", tC.output.Size, size)
+			}
+		})
+	}
+}
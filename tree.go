@@ -0,0 +1,128 @@
+package graph
+
+// Tree is the result of a traversal.
+type Tree[K comparable] struct {
+	Root K
+	// Vertex from which every reached vertex, except the root, was discovered.
+	Parent map[K]K
+	// Amount of tree edges between the root and every reached vertex.
+	Depth map[K]uint
+	// Vertices in order they were discovered.
+	Discovery []K
+	// Vertices in order all their neighbors were explored.
+	Finish []K
+}
+
+func newTree[K comparable](root K) *Tree[K] {
+	return &Tree[K]{
+		Root:      root,
+		Parent:    make(map[K]K),
+		Depth:     make(map[K]uint),
+		Discovery: []K{},
+		Finish:    []K{},
+	}
+}
+
+// PathTo returns tree vertices from the root to the given one.
+// Returns nil if the vertex was not reached.
+func (t *Tree[K]) PathTo(vertex K) []K {
+	depth, ok := t.Depth[vertex]
+	if !ok {
+		return nil
+	}
+
+	path := make([]K, depth+1)
+	for i := int(depth); i > 0; i-- {
+		path[i] = vertex
+		vertex = t.Parent[vertex]
+	}
+	path[0] = vertex
+	return path
+}
+
+// BFSTree works as BFS, but also records how every vertex was reached.
+// A vertex for which while returns false is not part of the tree.
+func BFSTree[K comparable](g Graph[K], entry K, while func(vertex K, depth uint) bool, opts ...SearchOptions) (*Tree[K], error) {
+	type item struct {
+		vertex, parent K
+		depth          uint
+	}
+
+	b := newBudget(opts)
+	tree := newTree(entry)
+	queue := []item{{vertex: entry}}
+	seen := map[K]struct{}{entry: {}}
+
+	for len(queue) > 0 {
+		bottom := queue[0]
+		queue = queue[1:]
+
+		if !b.reaches(bottom.depth) {
+			return tree, ErrBudgetExceeded
+		}
+		if err := b.visit(); err != nil {
+			return tree, err
+		}
+
+		if !while(bottom.vertex, bottom.depth) {
+			return tree, nil
+		}
+
+		n := g.Adjacency(bottom.vertex)
+		if n == nil {
+			return tree, ErrNilVertex
+		}
+
+		if bottom.depth > 0 {
+			tree.Parent[bottom.vertex] = bottom.parent
+		}
+		tree.Depth[bottom.vertex] = bottom.depth
+		tree.Discovery = append(tree.Discovery, bottom.vertex)
+
+		for _, neighbor := range n {
+			if _, ok := seen[neighbor]; ok {
+				continue
+			}
+			seen[neighbor] = struct{}{}
+			queue = append(queue, item{neighbor, bottom.vertex, bottom.depth + 1})
+		}
+		tree.Finish = append(tree.Finish, bottom.vertex)
+	}
+
+	return tree, nil
+}
+
+// DFSTree works as DFS and visits vertices in the same order,
+// but also records how every vertex was reached.
+// A vertex for which while returns false is not part of the tree.
+func DFSTree[K comparable](g Graph[K], entry K, while func(vertex K) bool, opts ...SearchOptions) (*Tree[K], error) {
+	tree := newTree(entry)
+
+	var parent K
+	err := DFSVisit(g, []K{entry}, DFSVisitorFuncs[K]{
+		Tree: func(from, to K) bool {
+			parent = from
+			return true
+		},
+		Discover: func(vertex K) bool {
+			if !while(vertex) {
+				return false
+			}
+
+			if len(tree.Discovery) > 0 {
+				tree.Parent[vertex] = parent
+				tree.Depth[vertex] = tree.Depth[parent] + 1
+			} else {
+				tree.Depth[vertex] = 0
+			}
+			tree.Discovery = append(tree.Discovery, vertex)
+			return true
+		},
+		Finish: func(vertex K) bool {
+			tree.Finish = append(tree.Finish, vertex)
+			return true
+		},
+	}, opts...)
+
+	return tree, err
+}
@@ -0,0 +1,178 @@
+package graph_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/axseem/graph"
+)
+
+type treeTest struct {
+	desc      string
+	order     uint
+	edges     [][2]uint
+	entry     uint
+	discovery []uint
+	finish    []uint
+	depth     map[uint]uint
+	path      []uint
+	err       error
+}
+
+func TestBFSTree(t *testing.T) {
+	testCases := []treeTest{
+		{
+			desc:      "empty graph",
+			order:     0,
+			entry:     0,
+			discovery: []uint{},
+			finish:    []uint{},
+			depth:     map[uint]uint{},
+			err:       graph.ErrNilVertex,
+		},
+		{
+			desc:      "graph:0→1",
+			order:     2,
+			edges:     [][2]uint{{0, 1}},
+			entry:     0,
+			discovery: []uint{0, 1},
+			finish:    []uint{0, 1},
+			depth:     map[uint]uint{0: 0, 1: 1},
+			path:      []uint{0, 1},
+		},
+		{
+			desc:      "visited neighbors",
+			order:     4,
+			edges:     [][2]uint{{0, 1}, {0, 2}, {1, 0}, {1, 2}, {2, 1}, {2, 3}},
+			entry:     0,
+			discovery: []uint{0, 1, 2, 3},
+			finish:    []uint{0, 1, 2, 3},
+			depth:     map[uint]uint{0: 0, 1: 1, 2: 1, 3: 2},
+			path:      []uint{0, 2, 3},
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := newIndexed(tC.order, tC.edges)
+
+			tree, err := graph.BFSTree(g, tC.entry, func(vertex, depth uint) bool {
+				return true
+			})
+			if err != tC.err {
+				t.Fatalf("expected error: %v, got: %v", tC.err, err)
+			}
+			checkTree(t, tC, tree)
+		})
+	}
+}
+
+func TestDFSTree(t *testing.T) {
+	testCases := []treeTest{
+		{
+			desc:      "empty graph",
+			order:     0,
+			entry:     0,
+			discovery: []uint{},
+			finish:    []uint{},
+			depth:     map[uint]uint{},
+			err:       graph.ErrNilVertex,
+		},
+		{
+			desc:      "graph:4←3←0→1→2",
+			order:     5,
+			edges:     [][2]uint{{3, 4}, {0, 3}, {0, 1}, {1, 2}},
+			entry:     0,
+			discovery: []uint{0, 1, 2, 3, 4},
+			finish:    []uint{2, 1, 4, 3, 0},
+			depth:     map[uint]uint{0: 0, 1: 1, 2: 2, 3: 1, 4: 2},
+			path:      []uint{0, 3, 4},
+		},
+		{
+			desc:      "visited neighbors",
+			order:     4,
+			edges:     [][2]uint{{0, 2}, {0, 1}, {1, 0}, {1, 2}, {2, 1}, {2, 3}},
+			entry:     0,
+			discovery: []uint{0, 1, 2, 3},
+			finish:    []uint{3, 2, 1, 0},
+			depth:     map[uint]uint{0: 0, 1: 1, 2: 2, 3: 3},
+			path:      []uint{0, 1, 2, 3},
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := newIndexed(tC.order, tC.edges)
+
+			tree, err := graph.DFSTree(g, tC.entry, func(vertex uint) bool {
+				return true
+			})
+			if err != tC.err {
+				t.Fatalf("expected error: %v, got: %v", tC.err, err)
+			}
+			checkTree(t, tC, tree)
+
+			order := []uint{}
+			graph.DFS(g, tC.entry, func(vertex uint) bool {
+				order = append(order, vertex)
+				return true
+			})
+			if err == nil && !reflect.DeepEqual(order, tree.Discovery) {
+				t.Errorf("expected DFS order: %v, got: %v", order, tree.Discovery)
+			}
+		})
+	}
+}
+
+func TestTreePathTo(t *testing.T) {
+	g := newIndexed(3, [][2]uint{{0, 1}})
+
+	tree, err := graph.BFSTree(g, 0, func(vertex, depth uint) bool {
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if path := tree.PathTo(0); !reflect.DeepEqual([]uint{0}, path) {
+		t.Errorf("expected: %v, got: %v", []uint{0}, path)
+	}
+	if path := tree.PathTo(2); path != nil {
+		t.Errorf("expected: %v, got: %v", nil, path)
+	}
+}
+
+func TestBFSTreeGrid(t *testing.T) {
+	g := graph.NewGrid()
+
+	tree, err := graph.BFSTree(g, [2]int{0, 0}, func(vertex [2]int, depth uint) bool {
+		return depth <= 3
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := tree.PathTo([2]int{2, 1})
+	if len(path) != 4 || path[0] != [2]int{0, 0} || path[3] != [2]int{2, 1} {
+		t.Errorf("expected shortest path to (2, 1), got: %v", path)
+	}
+}
+
+func checkTree(t *testing.T, tC treeTest, tree *graph.Tree[uint]) {
+	t.Helper()
+
+	if !reflect.DeepEqual(tC.discovery, tree.Discovery) {
+		t.Errorf("expected discovery: %v, got: %v", tC.discovery, tree.Discovery)
+	}
+	if !reflect.DeepEqual(tC.finish, tree.Finish) {
+		t.Errorf("expected finish: %v, got: %v", tC.finish, tree.Finish)
+	}
+	if !reflect.DeepEqual(tC.depth, tree.Depth) {
+		t.Errorf("expected depth: %v, got: %v", tC.depth, tree.Depth)
+	}
+	if len(tC.discovery) > 0 {
+		if path := tree.PathTo(tC.discovery[len(tC.discovery)-1]); !reflect.DeepEqual(tC.path, path) {
+			t.Errorf("expected path: %v, got: %v", tC.path, path)
+		}
+	}
+}
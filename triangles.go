@@ -0,0 +1,110 @@
+package graph
+
+// Triangles returns the amount of triangles in the graph. The graph is
+// treated as undirected, an edge present in both directions is counted once.
+func Triangles[K comparable](g GraphReader[K]) (int, error) {
+	c, err := newCompact(g)
+	if err != nil {
+		return 0, err
+	}
+
+	var total int
+	for _, count := range triangles(c.undirected()) {
+		total += count
+	}
+	return total / 3, nil
+}
+
+// LocalClustering returns for each vertex the fraction of pairs of its
+// neighbors that are connected. Vertices with less than two neighbors get zero.
+// Neighbors and triangles are the ones Triangles counts.
+func LocalClustering[K comparable](g GraphReader[K]) (map[K]float64, error) {
+	c, err := newCompact(g)
+	if err != nil {
+		return nil, err
+	}
+
+	adj := c.undirected()
+	counts := triangles(adj)
+	coefficients := make([]float64, len(adj))
+	for u, count := range counts {
+		degree := len(adj[u])
+		if degree > 1 {
+			coefficients[u] = 2 * float64(count) / float64(degree*(degree-1))
+		}
+	}
+	return c.values(coefficients), nil
+}
+
+// AverageClustering returns the mean of local clustering coefficients.
+func AverageClustering[K comparable](g GraphReader[K]) (float64, error) {
+	coefficients, err := LocalClustering(g)
+	if err != nil || len(coefficients) == 0 {
+		return 0, err
+	}
+
+	var sum float64
+	for _, coefficient := range coefficients {
+		sum += coefficient
+	}
+	return sum / float64(len(coefficients)), nil
+}
+
+// Transitivity returns the fraction of connected triples of vertices
+// that form triangles, counted as in Triangles.
+func Transitivity[K comparable](g GraphReader[K]) (float64, error) {
+	c, err := newCompact(g)
+	if err != nil {
+		return 0, err
+	}
+
+	adj := c.undirected()
+	var closed, triples int
+	for u, count := range triangles(adj) {
+		degree := len(adj[u])
+		closed += count
+		triples += degree * (degree - 1) / 2
+	}
+	if triples == 0 {
+		return 0, nil
+	}
+	return float64(closed) / float64(triples), nil
+}
+
+// triangles returns the amount of triangles every vertex belongs to.
+// Each edge is oriented from the vertex of lower degree to the higher one,
+// so every triangle is found exactly once and high degree vertices
+// are never scanned as the middle of a wedge.
+func triangles(adj [][]int) []int {
+	n := len(adj)
+	less := func(u, v int) bool {
+		return len(adj[u]) < len(adj[v]) || len(adj[u]) == len(adj[v]) && u < v
+	}
+
+	out := make([][]int, n)
+	for u, neighbors := range adj {
+		for _, v := range neighbors {
+			if less(u, v) {
+				out[u] = append(out[u], v)
+			}
+		}
+	}
+
+	counts := make([]int, n)
+	mark := make([]int, n)
+	for u := range n {
+		for _, v := range out[u] {
+			mark[v] = u + 1
+		}
+		for _, v := range out[u] {
+			for _, w := range out[v] {
+				if mark[w] == u+1 {
+					counts[u]++
+					counts[v]++
+					counts[w]++
+				}
+			}
+		}
+	}
+	return counts
+}
@@ -0,0 +1,124 @@
+package graph_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/axseem/graph"
+)
+
+func TestTriangles(t *testing.T) {
+	testCases := []struct {
+		desc         string
+		order        uint
+		edges        [][2]uint
+		triangles    int
+		local        []float64
+		average      float64
+		transitivity float64
+	}{
+		{
+			desc:  "empty graph",
+			order: 0,
+			local: []float64{},
+		},
+		{
+			desc:  "path",
+			order: 3,
+			edges: [][2]uint{{0, 1}, {1, 2}},
+			local: []float64{0, 0, 0},
+		},
+		{
+			desc:         "3 vertices cycled directed graph",
+			order:        3,
+			edges:        [][2]uint{{0, 1}, {1, 2}, {2, 0}},
+			triangles:    1,
+			local:        []float64{1, 1, 1},
+			average:      1,
+			transitivity: 1,
+		},
+		{
+			desc:         "triangle with pendant",
+			order:        4,
+			edges:        undirected([][2]uint{{0, 1}, {1, 2}, {2, 0}, {2, 3}}),
+			triangles:    1,
+			local:        []float64{1, 1, 1.0 / 3, 0},
+			average:      (1 + 1 + 1.0/3) / 4,
+			transitivity: 3.0 / 5,
+		},
+		{
+			desc:         "complete graph",
+			order:        4,
+			edges:        undirected([][2]uint{{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3}}),
+			triangles:    4,
+			local:        []float64{1, 1, 1, 1},
+			average:      1,
+			transitivity: 1,
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := newIndexed(tC.order, tC.edges)
+
+			triangles, err := graph.Triangles(g)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if triangles != tC.triangles {
+				t.Errorf("expected triangles: %v, got: %v", tC.triangles, triangles)
+			}
+
+			local, err := graph.LocalClustering(g)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !equalScores(tC.local, local) {
+				t.Errorf("expected local clustering: %v, got: %v", tC.local, local)
+			}
+
+			average, err := graph.AverageClustering(g)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if math.Abs(average-tC.average) > 1e-9 {
+				t.Errorf("expected average clustering: %v, got: %v", tC.average, average)
+			}
+
+			transitivity, err := graph.Transitivity(g)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if math.Abs(transitivity-tC.transitivity) > 1e-9 {
+				t.Errorf("expected transitivity: %v, got: %v", tC.transitivity, transitivity)
+			}
+		})
+	}
+}
+
+func BenchmarkTriangles(b *testing.B) {
+	const side = 300
+	g := graph.NewIndexed[uint32]()
+	g.AddVertices(side * side)
+	for y := range uint32(side) {
+		for x := range uint32(side) {
+			v := y*side + x
+			if x+1 < side {
+				g.AddEdges([2]uint32{v, v + 1})
+			}
+			if y+1 < side {
+				g.AddEdges([2]uint32{v, v + side})
+			}
+			if x+1 < side && y+1 < side {
+				g.AddEdges([2]uint32{v, v + side + 1})
+			}
+		}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := graph.Triangles(g); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package graph
+
+// Literal is a boolean variable or its negation.
+type Literal[K comparable] struct {
+	Var K
+	Neg bool
+}
+
+// TwoSAT finds values of variables that satisfy every clause,
+// a clause is satisfied when at least one of its literals is true.
+// Each clause turns into two implications, a literal is true in the
+// assignment if its strong component comes after the one of its negation.
+// If there is no such assignment, returns ErrUnsatisfiable along with a core,
+// a cycle of implications going through a literal and its negation.
+// Each literal of the core implies the next one and the last implies the first.
+func TwoSAT[K comparable](clauses [][2]Literal[K]) (map[K]bool, []Literal[K], error) {
+	// variable i is vertex 2i, its negation is vertex 2i+1
+	index := make(map[K]uint)
+	var variables []K
+	vertex := func(l Literal[K]) uint {
+		i, ok := index[l.Var]
+		if !ok {
+			i = uint(len(variables))
+			index[l.Var] = i
+			variables = append(variables, l.Var)
+		}
+		if l.Neg {
+			return 2*i + 1
+		}
+		return 2 * i
+	}
+	literal := func(v uint) Literal[K] {
+		return Literal[K]{variables[v/2], v%2 == 1}
+	}
+
+	edges := make([][2]uint, 0, 2*len(clauses))
+	for _, clause := range clauses {
+		a, b := vertex(clause[0]), vertex(clause[1])
+		// a clause with a variable and its negation always holds
+		if a^1 == b {
+			continue
+		}
+		edges = append(edges, [2]uint{a ^ 1, b}, [2]uint{b ^ 1, a})
+	}
+
+	g := NewIndexed[uint]()
+	if err := g.AddVertices(uint(2 * len(variables))); err != nil {
+		return nil, nil, err
+	}
+	if err := g.AddEdges(edges...); err != nil {
+		return nil, nil, err
+	}
+	c, err := newCompact(g)
+	if err != nil {
+		return nil, nil, err
+	}
+	labels := strongComponents(c.adj, 0)
+
+	for i := range variables {
+		x := uint(2 * i)
+		if labels[x] != labels[x+1] {
+			continue
+		}
+
+		// both directions are found by BFS, so the core is the shortest one
+		var core []Literal[K]
+		for _, ends := range [][2]uint{{x, x + 1}, {x + 1, x}} {
+			tree, err := BFSTree(g, ends[0], func(vertex uint, depth uint) bool { return true })
+			if err != nil {
+				return nil, nil, err
+			}
+			path := tree.PathTo(ends[1])
+			for _, v := range path[:len(path)-1] {
+				core = append(core, literal(v))
+			}
+		}
+		return nil, core, ErrUnsatisfiable
+	}
+
+	// components are labeled in reverse topological order
+	assignment := make(map[K]bool, len(variables))
+	for i, variable := range variables {
+		assignment[variable] = labels[2*i] < labels[2*i+1]
+	}
+	return assignment, nil, nil
+}
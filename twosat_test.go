@@ -0,0 +1,115 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/axseem/graph"
+)
+
+func pos(v string) graph.Literal[string] { return graph.Literal[string]{Var: v} }
+func neg(v string) graph.Literal[string] { return graph.Literal[string]{Var: v, Neg: true} }
+
+func satisfied(clauses [][2]graph.Literal[string], assignment map[string]bool) bool {
+	for _, clause := range clauses {
+		if assignment[clause[0].Var] == clause[0].Neg && assignment[clause[1].Var] == clause[1].Neg {
+			return false
+		}
+	}
+	return true
+}
+
+func TestTwoSAT(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		clauses [][2]graph.Literal[string]
+		sat     bool
+	}{
+		{
+			desc: "no clauses",
+			sat:  true,
+		},
+		{
+			desc: "feature flags",
+			clauses: [][2]graph.Literal[string]{
+				// new checkout requires new cart
+				{neg("checkout"), pos("cart")},
+				// new cart and legacy api are incompatible
+				{neg("cart"), neg("legacy")},
+				// either legacy api or new checkout must be on
+				{pos("legacy"), pos("checkout")},
+			},
+			sat: true,
+		},
+		{
+			desc: "tautology",
+			clauses: [][2]graph.Literal[string]{
+				{pos("a"), neg("a")},
+				{neg("a"), neg("a")},
+			},
+			sat: true,
+		},
+		{
+			desc: "contradiction",
+			clauses: [][2]graph.Literal[string]{
+				{pos("a"), pos("a")},
+				{neg("a"), neg("a")},
+			},
+		},
+		{
+			desc: "conflicting chain",
+			clauses: [][2]graph.Literal[string]{
+				{pos("a"), pos("b")},
+				{pos("a"), neg("b")},
+				{neg("a"), pos("c")},
+				{neg("a"), neg("c")},
+				{pos("d"), pos("e")},
+			},
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			assignment, core, err := graph.TwoSAT(tC.clauses)
+			if tC.sat {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !satisfied(tC.clauses, assignment) {
+					t.Errorf("assignment %v does not satisfy %v", assignment, tC.clauses)
+				}
+				return
+			}
+
+			if err != graph.ErrUnsatisfiable {
+				t.Fatalf("expected error: %v, got: %v", graph.ErrUnsatisfiable, err)
+			}
+			if !validCore(tC.clauses, core) {
+				t.Errorf("core %v is not a cycle of implications through a conflict", core)
+			}
+		})
+	}
+}
+
+// validCore checks that the core is a cycle of implications
+// that goes through some literal and its negation.
+func validCore(clauses [][2]graph.Literal[string], core []graph.Literal[string]) bool {
+	implies := func(a, b graph.Literal[string]) bool {
+		notA := graph.Literal[string]{Var: a.Var, Neg: !a.Neg}
+		for _, clause := range clauses {
+			if clause[0] == notA && clause[1] == b || clause[1] == notA && clause[0] == b {
+				return true
+			}
+		}
+		return false
+	}
+
+	conflict := false
+	for i, l := range core {
+		if !implies(l, core[(i+1)%len(core)]) {
+			return false
+		}
+		for _, other := range core {
+			conflict = conflict || other.Var == l.Var && other.Neg != l.Neg
+		}
+	}
+	return conflict
+}
@@ -0,0 +1,155 @@
+package graph
+
+// DFSVisitor receives events of depth first search.
+// Returning false from any method stops the search.
+type DFSVisitor[K comparable] interface {
+	// Vertex is reached for the first time.
+	DiscoverVertex(vertex K) bool
+	// All neighbors of the vertex are explored.
+	FinishVertex(vertex K) bool
+	// Edge leads to an undiscovered vertex, its discovery follows.
+	TreeEdge(from, to K) bool
+	// Edge leads to an ancestor that is not finished yet, loops included.
+	BackEdge(from, to K) bool
+	// Edge leads to an already finished descendant.
+	ForwardEdge(from, to K) bool
+	// Edge leads to a finished vertex that is not a descendant.
+	CrossEdge(from, to K) bool
+}
+
+// DFSVisitorFuncs implements DFSVisitor with optional functions.
+// Events without a function are ignored.
+type DFSVisitorFuncs[K comparable] struct {
+	Discover func(vertex K) bool
+	Finish   func(vertex K) bool
+	Tree     func(from, to K) bool
+	Back     func(from, to K) bool
+	Forward  func(from, to K) bool
+	Cross    func(from, to K) bool
+}
+
+func (v DFSVisitorFuncs[K]) DiscoverVertex(vertex K) bool {
+	return v.Discover == nil || v.Discover(vertex)
+}
+
+func (v DFSVisitorFuncs[K]) FinishVertex(vertex K) bool {
+	return v.Finish == nil || v.Finish(vertex)
+}
+
+func (v DFSVisitorFuncs[K]) TreeEdge(from, to K) bool {
+	return v.Tree == nil || v.Tree(from, to)
+}
+
+func (v DFSVisitorFuncs[K]) BackEdge(from, to K) bool {
+	return v.Back == nil || v.Back(from, to)
+}
+
+func (v DFSVisitorFuncs[K]) ForwardEdge(from, to K) bool {
+	return v.Forward == nil || v.Forward(from, to)
+}
+
+func (v DFSVisitorFuncs[K]) CrossEdge(from, to K) bool {
+	return v.Cross == nil || v.Cross(from, to)
+}
+
+// DFSVisit runs depth first search from every entry that is not yet
+// discovered and reports its events to the visitor.
+// Vertices are discovered in the same order as DFS visits them.
+// The function exits if the visitor returns false or there are no more vertices.
+// Edges that lead deeper than the depth budget are skipped without events.
+func DFSVisit[K comparable](g Graph[K], entries []K, visitor DFSVisitor[K], opts ...SearchOptions) error {
+	type frame struct {
+		vertex    K
+		neighbors []K
+	}
+
+	b := newBudget(opts)
+	discovery := make(map[K]int)
+	finished := make(map[K]struct{})
+	// vertices skipped for being too deep, they may be reached by a shorter path later
+	var cut []K
+
+	for _, entry := range entries {
+		if _, ok := discovery[entry]; ok {
+			continue
+		}
+
+		n := g.Adjacency(entry)
+		if n == nil {
+			return ErrNilVertex
+		}
+		if err := b.visit(); err != nil {
+			return err
+		}
+		discovery[entry] = len(discovery)
+		if !visitor.DiscoverVertex(entry) {
+			return nil
+		}
+
+		stack := []frame{{entry, n}}
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			from := top.vertex
+
+			if len(top.neighbors) == 0 {
+				stack = stack[:len(stack)-1]
+				finished[from] = struct{}{}
+				if !visitor.FinishVertex(from) {
+					return nil
+				}
+				continue
+			}
+
+			// neighbors are explored from the last one, as DFS pops them from its stack
+			to := top.neighbors[len(top.neighbors)-1]
+			top.neighbors = top.neighbors[:len(top.neighbors)-1]
+
+			order, discovered := discovery[to]
+			_, done := finished[to]
+
+			var ok bool
+			switch {
+			case !discovered:
+				if !b.reaches(uint(len(stack))) {
+					cut = append(cut, to)
+					continue
+				}
+
+				n := g.Adjacency(to)
+				if n == nil {
+					return ErrNilVertex
+				}
+				if err := b.visit(); err != nil {
+					return err
+				}
+				if !visitor.TreeEdge(from, to) {
+					return nil
+				}
+
+				discovery[to] = len(discovery)
+				if !visitor.DiscoverVertex(to) {
+					return nil
+				}
+				stack = append(stack, frame{to, n})
+				continue
+			case !done:
+				ok = visitor.BackEdge(from, to)
+			case order > discovery[from]:
+				ok = visitor.ForwardEdge(from, to)
+			default:
+				ok = visitor.CrossEdge(from, to)
+			}
+
+			if !ok {
+				return nil
+			}
+		}
+	}
+
+	for _, vertex := range cut {
+		if _, ok := discovery[vertex]; !ok {
+			return ErrBudgetExceeded
+		}
+	}
+	return nil
+}
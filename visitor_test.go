@@ -0,0 +1,140 @@
+package graph_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/axseem/graph"
+)
+
+func TestDFSVisit(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		order   uint
+		edges   [][2]uint
+		entries []uint
+		events  []string
+		err     error
+	}{
+		{
+			desc:    "empty graph",
+			order:   0,
+			entries: []uint{0},
+			events:  []string{},
+			err:     graph.ErrNilVertex,
+		},
+		{
+			desc:    "graph:0→1",
+			order:   2,
+			edges:   [][2]uint{{0, 1}},
+			entries: []uint{0},
+			events:  []string{"discover 0", "tree 0→1", "discover 1", "finish 1", "finish 0"},
+		},
+		{
+			desc:    "every edge kind",
+			order:   4,
+			edges:   [][2]uint{{0, 2}, {0, 1}, {1, 2}, {2, 0}, {3, 1}},
+			entries: []uint{0, 3},
+			events: []string{
+				"discover 0",
+				"tree 0→1", "discover 1",
+				"tree 1→2", "discover 2",
+				"back 2→0", "finish 2", "finish 1",
+				"forward 0→2", "finish 0",
+				"discover 3", "cross 3→1", "finish 3",
+			},
+		},
+		{
+			desc:    "entry discovered by previous entry",
+			order:   2,
+			edges:   [][2]uint{{0, 1}},
+			entries: []uint{0, 1},
+			events:  []string{"discover 0", "tree 0→1", "discover 1", "finish 1", "finish 0"},
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := newIndexed(tC.order, tC.edges)
+
+			events := []string{}
+			vertex := func(kind string) func(uint) bool {
+				return func(v uint) bool {
+					events = append(events, fmt.Sprintf("%s %d", kind, v))
+					return true
+				}
+			}
+			edge := func(kind string) func(uint, uint) bool {
+				return func(from, to uint) bool {
+					events = append(events, fmt.Sprintf("%s %d→%d", kind, from, to))
+					return true
+				}
+			}
+
+			err := graph.DFSVisit(g, tC.entries, graph.DFSVisitorFuncs[uint]{
+				Discover: vertex("discover"),
+				Finish:   vertex("finish"),
+				Tree:     edge("tree"),
+				Back:     edge("back"),
+				Forward:  edge("forward"),
+				Cross:    edge("cross"),
+			})
+			if err != tC.err {
+				t.Fatalf("expected error: %v, got: %v", tC.err, err)
+			}
+
+			if !reflect.DeepEqual(tC.events, events) {
+				t.Errorf("expected: %v, got: %v", tC.events, events)
+			}
+		})
+	}
+}
+
+func TestDFSVisitCycleDetection(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		order uint
+		edges [][2]uint
+		cycle bool
+	}{
+		{
+			desc:  "null graph",
+			order: 3,
+			cycle: false,
+		},
+		{
+			desc:  "diamond",
+			order: 4,
+			edges: [][2]uint{{0, 1}, {0, 2}, {1, 3}, {2, 3}},
+			cycle: false,
+		},
+		{
+			desc:  "3 vertices cycled directed graph",
+			order: 3,
+			edges: [][2]uint{{0, 1}, {1, 2}, {2, 0}},
+			cycle: true,
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := newIndexed(tC.order, tC.edges)
+
+			cycle := false
+			err := graph.DFSVisit(g, g.Vertices(), graph.DFSVisitorFuncs[uint]{
+				Back: func(from, to uint) bool {
+					cycle = true
+					return false
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if cycle != tC.cycle {
+				t.Errorf("expected: %v, got: %v", tC.cycle, cycle)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package graph
+
+import "slices"
+
+// KShortestPaths finds up to k cheapest paths without repeated vertices
+// between two vertices using Yen's algorithm. Paths are passed to while in
+// order of their cost, the function exits if while returns false.
+// If k is not positive, paths are passed until there are no more of them.
+// Returns ErrNoPath if there is not a single path.
+// Edge weights must not be negative.
+func KShortestPaths[K comparable, N Number](g WeightedGraph[K, N], from, to K, k int, while func(path Path[K, N]) bool, opts ...SearchOptions) error {
+	b := newBudget(opts)
+
+	first, err := dijkstra(g, from, to, b, nil)
+	if err != nil {
+		return err
+	}
+
+	found := []Path[K, N]{first}
+	var candidates []Path[K, N]
+	for {
+		last := found[len(found)-1]
+		if !while(last) || len(found) == k {
+			return nil
+		}
+
+		// prefix[i] is the cost of the path up to its i-th vertex
+		prefix := make([]N, len(last.Vertices))
+		for i := 1; i < len(last.Vertices); i++ {
+			weights := g.EdgesValues([2]K{last.Vertices[i-1], last.Vertices[i]})
+			if len(weights) == 0 {
+				return ErrNilVertex
+			}
+			prefix[i] = prefix[i-1] + weights[0]
+		}
+
+		for i := 0; i < len(last.Vertices)-1; i++ {
+			spur := last.Vertices[i]
+			root := last.Vertices[:i+1]
+
+			// edges leaving the spur along already found paths with the same root
+			removedEdges := make(map[[2]K]struct{})
+			for _, path := range found {
+				if len(path.Vertices) > i+1 && slices.Equal(path.Vertices[:i+1], root) {
+					removedEdges[[2]K{spur, path.Vertices[i+1]}] = struct{}{}
+				}
+			}
+			// root vertices other than the spur keep the path loopless
+			removedVertices := make(map[K]struct{}, i)
+			for _, vertex := range root[:i] {
+				removedVertices[vertex] = struct{}{}
+			}
+
+			spurPath, err := dijkstra(g, spur, to, b, func(from, to K) bool {
+				if _, ok := removedVertices[to]; ok {
+					return true
+				}
+				_, ok := removedEdges[[2]K{from, to}]
+				return ok
+			})
+			if err == ErrNoPath {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			candidate := Path[K, N]{
+				Vertices: append(slices.Clone(root[:i]), spurPath.Vertices...),
+				Cost:     prefix[i] + spurPath.Cost,
+			}
+			if !containsPath(candidates, candidate) && !containsPath(found, candidate) {
+				candidates = append(candidates, candidate)
+			}
+		}
+
+		if len(candidates) == 0 {
+			return nil
+		}
+
+		// the first of the cheapest candidates keeps the order stable
+		cheapest := 0
+		for i, candidate := range candidates {
+			if candidate.Cost < candidates[cheapest].Cost {
+				cheapest = i
+			}
+		}
+		found = append(found, candidates[cheapest])
+		candidates = slices.Delete(candidates, cheapest, cheapest+1)
+	}
+}
+
+func containsPath[K comparable, N Number](paths []Path[K, N], path Path[K, N]) bool {
+	for _, p := range paths {
+		if slices.Equal(p.Vertices, path.Vertices) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,118 @@
+package graph_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/axseem/graph"
+)
+
+func TestKShortestPaths(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		vertices []string
+		edges    []edge[string, int]
+		from, to string
+		k        int
+		paths    [][]string
+		costs    []int
+		err      error
+	}{
+		{
+			desc:     "nil vertex",
+			vertices: []string{"a"},
+			from:     "b",
+			to:       "a",
+			err:      graph.ErrNilVertex,
+		},
+		{
+			desc:     "no path",
+			vertices: []string{"a", "b"},
+			from:     "a",
+			to:       "b",
+			err:      graph.ErrNoPath,
+		},
+		{
+			desc:     "trivial path",
+			vertices: []string{"a", "b"},
+			edges:    []edge[string, int]{{"a", "b", 1}, {"b", "a", 1}},
+			from:     "a",
+			to:       "a",
+			paths:    [][]string{{"a"}},
+			costs:    []int{0},
+		},
+		{
+			desc:     "first three",
+			vertices: []string{"c", "d", "e", "f", "g", "h"},
+			edges: []edge[string, int]{
+				{"c", "d", 3}, {"c", "e", 2}, {"d", "f", 4}, {"e", "d", 1}, {"e", "f", 2},
+				{"e", "g", 3}, {"f", "g", 2}, {"f", "h", 1}, {"g", "h", 2},
+			},
+			from:  "c",
+			to:    "h",
+			k:     3,
+			paths: [][]string{{"c", "e", "f", "h"}, {"c", "e", "g", "h"}, {"c", "d", "f", "h"}},
+			costs: []int{5, 7, 8},
+		},
+		{
+			desc:     "all paths",
+			vertices: []string{"a", "b", "c", "d"},
+			edges: []edge[string, int]{
+				{"a", "b", 1}, {"a", "c", 2}, {"b", "c", 2}, {"c", "b", 1},
+				{"b", "d", 3}, {"c", "d", 1},
+			},
+			from: "a",
+			to:   "d",
+			paths: [][]string{
+				{"a", "c", "d"}, {"a", "b", "d"}, {"a", "b", "c", "d"}, {"a", "c", "b", "d"},
+			},
+			costs: []int{3, 4, 4, 6},
+		},
+		{
+			desc:     "cycle is not followed",
+			vertices: []string{"a", "b", "c"},
+			edges:    []edge[string, int]{{"a", "b", 1}, {"b", "a", 1}, {"b", "c", 1}},
+			from:     "a",
+			to:       "c",
+			paths:    [][]string{{"a", "b", "c"}},
+			costs:    []int{2},
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			g := newWeighted(tC.vertices, tC.edges)
+
+			var paths [][]string
+			var costs []int
+			err := graph.KShortestPaths(g, tC.from, tC.to, tC.k, func(path graph.Path[string, int]) bool {
+				paths = append(paths, path.Vertices)
+				costs = append(costs, path.Cost)
+				return true
+			})
+			if err != tC.err {
+				t.Fatalf("expected error: %v, got: %v", tC.err, err)
+			}
+			if !reflect.DeepEqual(tC.paths, paths) || !reflect.DeepEqual(tC.costs, costs) {
+				t.Errorf("expected: %v %v, got: %v %v", tC.paths, tC.costs, paths, costs)
+			}
+		})
+	}
+}
+
+func TestKShortestPathsStop(t *testing.T) {
+	g := newWeighted([]int{0, 1, 2, 3}, []edge[int, int]{
+		{0, 1, 1}, {0, 2, 1}, {0, 3, 5}, {1, 3, 1}, {2, 3, 2},
+	})
+
+	var paths [][]int
+	err := graph.KShortestPaths(g, 0, 3, 0, func(path graph.Path[int, int]) bool {
+		paths = append(paths, path.Vertices)
+		return len(paths) < 2
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expect := [][]int{{0, 1, 3}, {0, 2, 3}}; !reflect.DeepEqual(expect, paths) {
+		t.Errorf("expected: %v, got: %v", expect, paths)
+	}
+}